@@ -1,72 +1,96 @@
 package main
 
 import (
-	"encoding/binary"
-	"os"
 	"strconv"
 	"strings"
 )
 
 type FBNode struct {
 	key   int
+	value string
 	left  *FBNode
 	right *FBNode
 }
 
 type FullBinaryTree struct {
-	root *FBNode
+	root       *FBNode
+	strictFull bool
 }
 
 func NewFullBinaryTree() *FullBinaryTree {
 	return &FullBinaryTree{}
 }
 
-func (fbt *FullBinaryTree) TINSERT(key int) {
-	fbt.insert(key)
+// TINSERT добавляет ключ со значением; для существующего ключа обновляет значение.
+// В строгом режиме новый ключ в непустое дерево молча не добавляется —
+// чтобы узнать об отказе, используйте TryInsert.
+func (fbt *FullBinaryTree) TINSERT(key int, value string) {
+	_ = fbt.TryInsert(key, value)
 }
 
+// TryInsert работает как TINSERT, но возвращает ErrNotFullTree,
+// если строгий режим не позволяет добавить новый ключ
+func (fbt *FullBinaryTree) TryInsert(key int, value string) error {
+	if node := fbt.find(fbt.root, key); node != nil {
+		node.value = value
+		return nil
+	}
+	if fbt.strictFull && fbt.root != nil {
+		return ErrNotFullTree
+	}
+	fbt.insert(key, value)
+	return nil
+}
+
+// TDEL удаляет ключ. В строгом режиме удаляется только единственный корень,
+// остальные ключи молча остаются — чтобы узнать об отказе, используйте TryDelete.
 func (fbt *FullBinaryTree) TDEL(key int) {
+	_ = fbt.TryDelete(key)
+}
+
+// TryDelete работает как TDEL, но возвращает ErrNotFound для отсутствующего
+// ключа и ErrNotFullTree, если строгий режим не позволяет удаление
+func (fbt *FullBinaryTree) TryDelete(key int) error {
+	if !fbt.search(fbt.root, key) {
+		return ErrNotFound
+	}
+	if fbt.strictFull && fbt.root.left != nil {
+		return ErrNotFullTree
+	}
 	fbt.remove(key)
+	return nil
 }
 
 func (fbt *FullBinaryTree) ISMEMBER(key int) bool {
 	return fbt.search(fbt.root, key)
 }
 
-func (fbt *FullBinaryTree) TGET(key int) string {
-	if fbt.search(fbt.root, key) {
-		return strconv.Itoa(key)
+func (fbt *FullBinaryTree) TGET(key int) (string, bool) {
+	if node := fbt.find(fbt.root, key); node != nil {
+		return node.value, true
 	}
-	return ""
+	return "", false
 }
 
 func (fbt *FullBinaryTree) PRINT_PREORDER() string {
-	res := make([]int, 0)
-	fbt.preorder(fbt.root, &res)
-	return fbt.vecToString(res)
+	return fbt.vecToString(fbt.PreorderKeys())
 }
 
 func (fbt *FullBinaryTree) PRINT_INORDER() string {
-	res := make([]int, 0)
-	fbt.inorder(fbt.root, &res)
-	return fbt.vecToString(res)
+	return fbt.vecToString(fbt.InorderKeys())
 }
 
 func (fbt *FullBinaryTree) PRINT_POSTORDER() string {
-	res := make([]int, 0)
-	fbt.postorder(fbt.root, &res)
-	return fbt.vecToString(res)
+	return fbt.vecToString(fbt.PostorderKeys())
 }
 
 func (fbt *FullBinaryTree) PRINT_BFS() string {
-	res := make([]int, 0)
-	fbt.bfs(fbt.root, &res)
-	return fbt.vecToString(res)
+	return fbt.vecToString(fbt.LevelOrderKeys())
 }
 
-func (fbt *FullBinaryTree) insert(key int) {
+func (fbt *FullBinaryTree) insert(key int, value string) {
 	if fbt.root == nil {
-		fbt.root = &FBNode{key: key}
+		fbt.root = &FBNode{key: key, value: value}
 		return
 	}
 
@@ -77,10 +101,10 @@ func (fbt *FullBinaryTree) insert(key int) {
 		queue = queue[1:]
 
 		if current.left == nil {
-			current.left = &FBNode{key: key}
+			current.left = &FBNode{key: key, value: value}
 			return
 		} else if current.right == nil {
-			current.right = &FBNode{key: key}
+			current.right = &FBNode{key: key, value: value}
 			return
 		} else {
 			queue = append(queue, current.left, current.right)
@@ -89,13 +113,16 @@ func (fbt *FullBinaryTree) insert(key int) {
 }
 
 func (fbt *FullBinaryTree) search(node *FBNode, key int) bool {
-	if node == nil {
-		return false
-	}
-	if node.key == key {
-		return true
+	return fbt.find(node, key) != nil
+}
+
+func (fbt *FullBinaryTree) find(node *FBNode, key int) *FBNode {
+	for current := range preorderNodes(node) {
+		if current.key == key {
+			return current
+		}
 	}
-	return fbt.search(node.left, key) || fbt.search(node.right, key)
+	return nil
 }
 
 func (fbt *FullBinaryTree) remove(key int) {
@@ -144,6 +171,7 @@ func (fbt *FullBinaryTree) remove(key int) {
 	}
 
 	keyNode.key = deepest.key
+	keyNode.value = deepest.value
 
 	if parentOfDeepest != nil {
 		if parentOfDeepest.left == deepest {
@@ -154,89 +182,6 @@ func (fbt *FullBinaryTree) remove(key int) {
 	}
 }
 
-func (fbt *FullBinaryTree) preorder(node *FBNode, result *[]int) {
-	if node == nil {
-		return
-	}
-	*result = append(*result, node.key)
-	fbt.preorder(node.left, result)
-	fbt.preorder(node.right, result)
-}
-
-func (fbt *FullBinaryTree) inorder(node *FBNode, result *[]int) {
-	if node == nil {
-		return
-	}
-	fbt.inorder(node.left, result)
-	*result = append(*result, node.key)
-	fbt.inorder(node.right, result)
-}
-
-func (fbt *FullBinaryTree) postorder(node *FBNode, result *[]int) {
-	if node == nil {
-		return
-	}
-	fbt.postorder(node.left, result)
-	fbt.postorder(node.right, result)
-	*result = append(*result, node.key)
-}
-
-func (fbt *FullBinaryTree) bfs(node *FBNode, result *[]int) {
-	if node == nil {
-		return
-	}
-
-	queue := []*FBNode{node}
-
-	for len(queue) > 0 {
-		current := queue[0]
-		queue = queue[1:]
-
-		*result = append(*result, current.key)
-
-		if current.left != nil {
-			queue = append(queue, current.left)
-		}
-		if current.right != nil {
-			queue = append(queue, current.right)
-		}
-	}
-}
-
-func (fbt *FullBinaryTree) bfsForSerialization(node *FBNode, result *[]int) {
-	if node == nil {
-		return
-	}
-
-	queue := []*FBNode{node}
-
-	for len(queue) > 0 {
-		current := queue[0]
-		queue = queue[1:]
-
-		*result = append(*result, current.key)
-
-		if current.left != nil {
-			queue = append(queue, current.left)
-		}
-		if current.right != nil {
-			queue = append(queue, current.right)
-		}
-	}
-}
-
-func (fbt *FullBinaryTree) buildCompleteTree(keys []int, index int) *FBNode {
-	if index >= len(keys) {
-		return nil
-	}
-
-	node := &FBNode{key: keys[index]}
-	node.left = fbt.buildCompleteTree(keys, 2*index+1)
-	node.right = fbt.buildCompleteTree(keys, 2*index+2)
-
-	return node
-}
-
 func (fbt *FullBinaryTree) vecToString(vec []int) string {
 	if len(vec) == 0 {
 		return ""
@@ -249,61 +194,6 @@ func (fbt *FullBinaryTree) vecToString(vec []int) string {
 	return strings.Join(strs, " ")
 }
 
-func (fbt *FullBinaryTree) SaveToBinary(filename string) error {
-	file, err := os.Create(filename)
-	if err != nil {
-		return err
-	}
-	defer file.Close()
-
-	keys := make([]int, 0)
-	fbt.bfsForSerialization(fbt.root, &keys)
-
-	size := len(keys)
-	err = binary.Write(file, binary.LittleEndian, int32(size))
-	if err != nil {
-		return err
-	}
-
-	for _, key := range keys {
-		err = binary.Write(file, binary.LittleEndian, int32(key))
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func (fbt *FullBinaryTree) LoadFromBinary(filename string) error {
-	file, err := os.Open(filename)
-	if err != nil {
-		return err
-	}
-	defer file.Close()
-
-	var size int32
-	err = binary.Read(file, binary.LittleEndian, &size)
-	if err != nil {
-		return err
-	}
-
-	if size > 0 {
-		keys := make([]int, size)
-		for i := 0; i < int(size); i++ {
-			var key int32
-			err = binary.Read(file, binary.LittleEndian, &key)
-			if err != nil {
-				return err
-			}
-			keys[i] = int(key)
-		}
-		fbt.root = fbt.buildCompleteTree(keys, 0)
-	} else {
-		fbt.root = nil
-	}
-	return nil
-}
-
 func (fbt *FullBinaryTree) Clear() {
 	fbt.root = nil
 }
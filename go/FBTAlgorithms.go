@@ -0,0 +1,185 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+)
+
+// PathTo возвращает ключи от корня до узла с key включительно или nil, если ключа нет
+func (fbt *FullBinaryTree) PathTo(key int) []int {
+	parents := make(map[*FBNode]*FBNode)
+	var target *FBNode
+	for node := range levelOrderNodes(fbt.root) {
+		if node.key == key {
+			target = node
+			break
+		}
+		if node.left != nil {
+			parents[node.left] = node
+		}
+		if node.right != nil {
+			parents[node.right] = node
+		}
+	}
+	if target == nil {
+		return nil
+	}
+
+	path := make([]int, 0)
+	for node := target; node != nil; node = parents[node] {
+		path = append(path, node.key)
+	}
+	slices.Reverse(path)
+	return path
+}
+
+// Depth — число рёбер от корня до узла с key или -1, если ключа нет
+func (fbt *FullBinaryTree) Depth(key int) int {
+	return len(fbt.PathTo(key)) - 1
+}
+
+func (fbt *FullBinaryTree) LowestCommonAncestor(a, b int) (int, bool) {
+	pathA := fbt.PathTo(a)
+	pathB := fbt.PathTo(b)
+	if pathA == nil || pathB == nil {
+		return 0, false
+	}
+
+	lca := pathA[0]
+	for i := 0; i < len(pathA) && i < len(pathB) && pathA[i] == pathB[i]; i++ {
+		lca = pathA[i]
+	}
+	return lca, true
+}
+
+// Mirror отражает дерево, меняя местами левых и правых детей у каждого узла
+func (fbt *FullBinaryTree) Mirror() {
+	for node := range levelOrderNodes(fbt.root) {
+		node.left, node.right = node.right, node.left
+	}
+}
+
+// Clone возвращает глубокую копию дерева вместе с режимом строгой полноты
+func (fbt *FullBinaryTree) Clone() *FullBinaryTree {
+	clone := &FullBinaryTree{strictFull: fbt.strictFull}
+	if fbt.root == nil {
+		return clone
+	}
+
+	clone.root = &FBNode{key: fbt.root.key, value: fbt.root.value}
+	pairs := [][2]*FBNode{{fbt.root, clone.root}}
+	for len(pairs) > 0 {
+		src, dst := pairs[len(pairs)-1][0], pairs[len(pairs)-1][1]
+		pairs = pairs[:len(pairs)-1]
+		if src.left != nil {
+			dst.left = &FBNode{key: src.left.key, value: src.left.value}
+			pairs = append(pairs, [2]*FBNode{src.left, dst.left})
+		}
+		if src.right != nil {
+			dst.right = &FBNode{key: src.right.key, value: src.right.value}
+			pairs = append(pairs, [2]*FBNode{src.right, dst.right})
+		}
+	}
+	return clone
+}
+
+// equalNodes сравнивает поддеревья по форме, ключам и значениям
+func equalNodes(a, b *FBNode) bool {
+	pairs := [][2]*FBNode{{a, b}}
+	for len(pairs) > 0 {
+		x, y := pairs[len(pairs)-1][0], pairs[len(pairs)-1][1]
+		pairs = pairs[:len(pairs)-1]
+		if x == nil || y == nil {
+			if x != y {
+				return false
+			}
+			continue
+		}
+		if x.key != y.key || x.value != y.value {
+			return false
+		}
+		pairs = append(pairs, [2]*FBNode{x.left, y.left}, [2]*FBNode{x.right, y.right})
+	}
+	return true
+}
+
+// Equal сообщает, совпадают ли деревья по форме, ключам и значениям
+func (fbt *FullBinaryTree) Equal(other *FullBinaryTree) bool {
+	return equalNodes(fbt.root, other.root)
+}
+
+// IsSubtree сообщает, совпадает ли other с поддеревом какого-либо узла;
+// пустое дерево считается поддеревом любого
+func (fbt *FullBinaryTree) IsSubtree(other *FullBinaryTree) bool {
+	if other.root == nil {
+		return true
+	}
+	for node := range preorderNodes(fbt.root) {
+		if node.key == other.root.key && equalNodes(node, other.root) {
+			return true
+		}
+	}
+	return false
+}
+
+// Diameter — число рёбер на самом длинном пути между двумя узлами
+func (fbt *FullBinaryTree) Diameter() int {
+	heights := make(map[*FBNode]int)
+	diameter := 0
+	for node := range postorderNodes(fbt.root) {
+		left, right := 0, 0
+		if node.left != nil {
+			left = heights[node.left] + 1
+		}
+		if node.right != nil {
+			right = heights[node.right] + 1
+		}
+		heights[node] = max(left, right)
+		diameter = max(diameter, left+right)
+	}
+	return diameter
+}
+
+// FromPreorderInorder восстанавливает дерево по прямому и симметричному обходам;
+// ключи должны быть уникальны, значения узлов остаются пустыми
+func FromPreorderInorder(preorder, inorder []int) (*FullBinaryTree, error) {
+	if len(preorder) != len(inorder) {
+		return nil, fmt.Errorf("traversals have different lengths")
+	}
+	tree := NewFullBinaryTree()
+	if len(preorder) == 0 {
+		return tree, nil
+	}
+
+	seen := make(map[int]bool, len(preorder))
+	for _, key := range preorder {
+		if seen[key] {
+			return nil, fmt.Errorf("duplicate key %d", key)
+		}
+		seen[key] = true
+	}
+
+	tree.root = &FBNode{key: preorder[0]}
+	stack := []*FBNode{tree.root}
+	j := 0
+	for _, key := range preorder[1:] {
+		node := &FBNode{key: key}
+		var parent *FBNode
+		for len(stack) > 0 && j < len(inorder) && stack[len(stack)-1].key == inorder[j] {
+			parent = stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			j++
+		}
+		if parent != nil {
+			parent.right = node
+		} else {
+			stack[len(stack)-1].left = node
+		}
+		stack = append(stack, node)
+	}
+
+	if !slices.Equal(tree.InorderKeys(), inorder) || !slices.Equal(tree.PreorderKeys(), preorder) {
+		return nil, fmt.Errorf("traversals do not describe the same tree")
+	}
+	return tree, nil
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestFullBinaryTreePathAndDepth(t *testing.T) {
+	tree := newSevenNodeTree()
+
+	if path := tree.PathTo(5); !slices.Equal(path, []int{1, 2, 5}) {
+		t.Errorf("Expected path [1 2 5], got %v", path)
+	}
+	if path := tree.PathTo(1); !slices.Equal(path, []int{1}) {
+		t.Errorf("Expected path [1], got %v", path)
+	}
+	if path := tree.PathTo(42); path != nil {
+		t.Errorf("Expected nil path for missing key, got %v", path)
+	}
+
+	if tree.Depth(1) != 0 || tree.Depth(3) != 1 || tree.Depth(7) != 2 {
+		t.Errorf("Unexpected depths %d %d %d", tree.Depth(1), tree.Depth(3), tree.Depth(7))
+	}
+	if tree.Depth(42) != -1 {
+		t.Errorf("Expected -1 for missing key, got %d", tree.Depth(42))
+	}
+}
+
+func TestFullBinaryTreeLowestCommonAncestor(t *testing.T) {
+	tree := newSevenNodeTree()
+
+	cases := []struct{ a, b, want int }{
+		{4, 5, 2},
+		{4, 7, 1},
+		{2, 4, 2},
+		{6, 6, 6},
+	}
+	for _, c := range cases {
+		if got, ok := tree.LowestCommonAncestor(c.a, c.b); !ok || got != c.want {
+			t.Errorf("LCA(%d, %d): expected %d, got %d", c.a, c.b, c.want, got)
+		}
+	}
+	if _, ok := tree.LowestCommonAncestor(4, 42); ok {
+		t.Error("Expected no LCA for missing key")
+	}
+}
+
+func TestFullBinaryTreeMirrorAndClone(t *testing.T) {
+	tree := newSevenNodeTree()
+	clone := tree.Clone()
+
+	if !tree.Equal(clone) {
+		t.Error("Expected clone to be equal to the original")
+	}
+
+	clone.Mirror()
+	if got := clone.PRINT_BFS(); got != "1 3 2 7 6 5 4" {
+		t.Errorf("Expected mirrored BFS '1 3 2 7 6 5 4', got '%s'", got)
+	}
+	if tree.PRINT_BFS() != "1 2 3 4 5 6 7" {
+		t.Errorf("Mirroring the clone changed the original: '%s'", tree.PRINT_BFS())
+	}
+	if tree.Equal(clone) {
+		t.Error("Expected mirrored tree to differ")
+	}
+
+	clone.Mirror()
+	if !tree.Equal(clone) {
+		t.Error("Expected double mirror to restore the tree")
+	}
+
+	clone.TINSERT(3, "changed")
+	if tree.Equal(clone) {
+		t.Error("Expected trees with different values to differ")
+	}
+}
+
+func TestFullBinaryTreeIsSubtree(t *testing.T) {
+	tree := newSevenNodeTree()
+
+	sub, err := FromPreorderInorder([]int{3, 6, 7}, []int{6, 3, 7})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !tree.IsSubtree(sub) {
+		t.Error("Expected {3, 6, 7} to be a subtree")
+	}
+
+	sub.Mirror()
+	if tree.IsSubtree(sub) {
+		t.Error("Expected mirrored {3, 7, 6} not to be a subtree")
+	}
+
+	if !tree.IsSubtree(NewFullBinaryTree()) {
+		t.Error("Expected empty tree to be a subtree")
+	}
+}
+
+func TestFullBinaryTreeDiameter(t *testing.T) {
+	if d := NewFullBinaryTree().Diameter(); d != 0 {
+		t.Errorf("Expected diameter 0 for empty tree, got %d", d)
+	}
+	if d := newSevenNodeTree().Diameter(); d != 4 {
+		t.Errorf("Expected diameter 4, got %d", d)
+	}
+
+	// самый длинный путь не проходит через корень
+	tree, err := FromPreorderInorder([]int{1, 2, 3, 4, 5, 6, 7}, []int{4, 3, 2, 5, 6, 7, 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := tree.Diameter(); d != 5 {
+		t.Errorf("Expected diameter 5, got %d", d)
+	}
+}
+
+func TestFromPreorderInorder(t *testing.T) {
+	tree, err := FromPreorderInorder([]int{1, 2, 4, 5, 3, 6, 7}, []int{4, 2, 5, 1, 6, 3, 7})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !tree.Equal(newSevenNodeTree()) {
+		t.Errorf("Expected seven node tree, got BFS '%s'", tree.PRINT_BFS())
+	}
+
+	empty, err := FromPreorderInorder(nil, nil)
+	if err != nil || empty.PRINT_BFS() != "" {
+		t.Errorf("Expected empty tree, got '%s', %v", empty.PRINT_BFS(), err)
+	}
+
+	invalid := map[string][2][]int{
+		"length mismatch": {{1, 2}, {1}},
+		"duplicate keys":  {{1, 1}, {1, 1}},
+		"different keys":  {{1, 2}, {1, 3}},
+		"inconsistent":    {{1, 2, 3}, {3, 1, 2}},
+	}
+	for name, c := range invalid {
+		if _, err := FromPreorderInorder(c[0], c[1]); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
@@ -0,0 +1,227 @@
+package main
+
+import "fmt"
+
+// levelNodes возвращает узлы дерева по уровням, начиная с корня
+func (fbt *FullBinaryTree) levelNodes() [][]*FBNode {
+	levels := make([][]*FBNode, 0)
+	if fbt.root == nil {
+		return levels
+	}
+
+	current := []*FBNode{fbt.root}
+	for len(current) > 0 {
+		levels = append(levels, current)
+		next := make([]*FBNode, 0, 2*len(current))
+		for _, node := range current {
+			if node.left != nil {
+				next = append(next, node.left)
+			}
+			if node.right != nil {
+				next = append(next, node.right)
+			}
+		}
+		current = next
+	}
+	return levels
+}
+
+// Height — число уровней дерева: 0 для пустого, 1 для одного корня
+func (fbt *FullBinaryTree) Height() int {
+	return len(fbt.levelNodes())
+}
+
+func (fbt *FullBinaryTree) Size() int {
+	size := 0
+	for _, level := range fbt.levelNodes() {
+		size += len(level)
+	}
+	return size
+}
+
+func (fbt *FullBinaryTree) LeafCount() int {
+	count := 0
+	for _, level := range fbt.levelNodes() {
+		for _, node := range level {
+			if node.left == nil && node.right == nil {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// Width — наибольшее число узлов на одном уровне
+func (fbt *FullBinaryTree) Width() int {
+	width := 0
+	for _, level := range fbt.levelNodes() {
+		width = max(width, len(level))
+	}
+	return width
+}
+
+// IsFull проверяет, что у каждого узла либо нет детей, либо их два
+func (fbt *FullBinaryTree) IsFull() bool {
+	for _, level := range fbt.levelNodes() {
+		for _, node := range level {
+			if (node.left == nil) != (node.right == nil) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// IsComplete проверяет, что все уровни, кроме последнего, заполнены,
+// а последний заполнен слева направо
+func (fbt *FullBinaryTree) IsComplete() bool {
+	if fbt.root == nil {
+		return true
+	}
+
+	queue := []*FBNode{fbt.root}
+	seenGap := false
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, child := range []*FBNode{current.left, current.right} {
+			if child == nil {
+				seenGap = true
+			} else if seenGap {
+				return false
+			} else {
+				queue = append(queue, child)
+			}
+		}
+	}
+	return true
+}
+
+// IsPerfect проверяет, что все внутренние узлы имеют двух детей, а листья лежат на одном уровне
+func (fbt *FullBinaryTree) IsPerfect() bool {
+	levels := fbt.levelNodes()
+	for depth, level := range levels {
+		if len(level) != 1<<depth {
+			return false
+		}
+	}
+	return true
+}
+
+// IsBalanced проверяет, что высоты поддеревьев любого узла различаются не более чем на 1
+func (fbt *FullBinaryTree) IsBalanced() bool {
+	_, balanced := balancedHeight(fbt.root)
+	return balanced
+}
+
+func balancedHeight(node *FBNode) (int, bool) {
+	if node == nil {
+		return 0, true
+	}
+	left, ok := balancedHeight(node.left)
+	if !ok {
+		return 0, false
+	}
+	right, ok := balancedHeight(node.right)
+	if !ok {
+		return 0, false
+	}
+	if left-right > 1 || right-left > 1 {
+		return 0, false
+	}
+	return 1 + max(left, right), true
+}
+
+// SetStrictFull включает режим, в котором дерево всегда остаётся полным.
+// У полного дерева нечётное число узлов, поэтому в этом режиме TINSERT
+// добавляет новый ключ только в пустое дерево (остальное — через InsertPair),
+// а TDEL удаляет только единственный корень (остальное — через DeletePair).
+// TryInsert и TryDelete сообщают о таком отказе ошибкой ErrNotFullTree.
+func (fbt *FullBinaryTree) SetStrictFull(enabled bool) error {
+	if enabled && !fbt.IsFull() {
+		return ErrNotFullTree
+	}
+	fbt.strictFull = enabled
+	return nil
+}
+
+func (fbt *FullBinaryTree) IsStrictFull() bool {
+	return fbt.strictFull
+}
+
+// InsertPair подвешивает два новых ключа детьми к первому в порядке BFS листу,
+// поэтому полное дерево остаётся полным
+func (fbt *FullBinaryTree) InsertPair(leftKey int, leftValue string, rightKey int, rightValue string) error {
+	if leftKey == rightKey {
+		return fmt.Errorf("keys must be distinct")
+	}
+	if fbt.search(fbt.root, leftKey) || fbt.search(fbt.root, rightKey) {
+		return fmt.Errorf("key already exists")
+	}
+	if fbt.root == nil {
+		return ErrNotFullTree
+	}
+
+	for _, level := range fbt.levelNodes() {
+		for _, node := range level {
+			if node.left == nil && node.right == nil {
+				node.left = &FBNode{key: leftKey, value: leftValue}
+				node.right = &FBNode{key: rightKey, value: rightValue}
+				return nil
+			}
+		}
+	}
+	return nil
+}
+
+// DeletePair удаляет два ключа полного дерева: последняя в порядке BFS пара
+// листьев-братьев отрезается, а их уцелевшие ключи переносятся на место удалённых
+func (fbt *FullBinaryTree) DeletePair(firstKey, secondKey int) error {
+	if firstKey == secondKey {
+		return fmt.Errorf("keys must be distinct")
+	}
+	if !fbt.IsFull() {
+		return ErrNotFullTree
+	}
+	first := fbt.find(fbt.root, firstKey)
+	second := fbt.find(fbt.root, secondKey)
+	if first == nil || second == nil {
+		return ErrNotFound
+	}
+
+	levels := fbt.levelNodes()
+	if len(levels) < 2 {
+		return ErrNotFullTree
+	}
+
+	// у последнего узла BFS брат — тоже лист, иначе его дети были бы глубже
+	last := levels[len(levels)-1]
+	deepest := last[len(last)-1]
+	var parent *FBNode
+	for _, node := range levels[len(levels)-2] {
+		if node.right == deepest {
+			parent = node
+		}
+	}
+	sibling := parent.left
+
+	removed := []*FBNode{sibling, deepest}
+	targets := make([]*FBNode, 0, 2)
+	for _, node := range []*FBNode{first, second} {
+		if node != sibling && node != deepest {
+			targets = append(targets, node)
+		}
+	}
+	for _, node := range removed {
+		if node != first && node != second {
+			targets[0].key = node.key
+			targets[0].value = node.value
+			targets = targets[1:]
+		}
+	}
+
+	parent.left = nil
+	parent.right = nil
+	return nil
+}
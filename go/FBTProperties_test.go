@@ -0,0 +1,209 @@
+package main
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestFullBinaryTreeMetrics(t *testing.T) {
+	tree := NewFullBinaryTree()
+	if tree.Height() != 0 || tree.Size() != 0 || tree.LeafCount() != 0 || tree.Width() != 0 {
+		t.Error("Expected zero metrics for empty tree")
+	}
+
+	for i := 1; i <= 6; i++ {
+		tree.TINSERT(i, strconv.Itoa(i))
+	}
+
+	if tree.Height() != 3 {
+		t.Errorf("Expected height 3, got %d", tree.Height())
+	}
+	if tree.Size() != 6 {
+		t.Errorf("Expected size 6, got %d", tree.Size())
+	}
+	if tree.LeafCount() != 3 {
+		t.Errorf("Expected 3 leaves, got %d", tree.LeafCount())
+	}
+	if tree.Width() != 3 {
+		t.Errorf("Expected width 3, got %d", tree.Width())
+	}
+}
+
+func TestFullBinaryTreeShapeChecks(t *testing.T) {
+	tree := NewFullBinaryTree()
+	if !tree.IsFull() || !tree.IsComplete() || !tree.IsPerfect() || !tree.IsBalanced() {
+		t.Error("Expected empty tree to satisfy every shape check")
+	}
+
+	for i := 1; i <= 7; i++ {
+		tree.TINSERT(i, "")
+	}
+	if !tree.IsFull() || !tree.IsComplete() || !tree.IsPerfect() || !tree.IsBalanced() {
+		t.Error("Expected 7-node tree to be full, complete, perfect and balanced")
+	}
+
+	tree.TINSERT(8, "")
+	if tree.IsFull() {
+		t.Error("Expected 8-node tree not to be full")
+	}
+	if !tree.IsComplete() || tree.IsPerfect() || !tree.IsBalanced() {
+		t.Error("Expected 8-node tree to be complete and balanced but not perfect")
+	}
+
+	// 1 -> 2 -> 4 по левому краю и 3 справа: полное, но не завершённое и не сбалансированное
+	skewed := NewFullBinaryTree()
+	skewed.root = &FBNode{key: 1,
+		left: &FBNode{key: 2,
+			left:  &FBNode{key: 4, left: &FBNode{key: 6}, right: &FBNode{key: 7}},
+			right: &FBNode{key: 5}},
+		right: &FBNode{key: 3}}
+	if !skewed.IsFull() {
+		t.Error("Expected skewed tree to be full")
+	}
+	if skewed.IsComplete() || skewed.IsPerfect() || skewed.IsBalanced() {
+		t.Error("Expected skewed tree not to be complete, perfect or balanced")
+	}
+}
+
+func TestFullBinaryTreeStrictMode(t *testing.T) {
+	tree := NewFullBinaryTree()
+	tree.TINSERT(1, "")
+	tree.TINSERT(2, "")
+	if tree.SetStrictFull(true) != ErrNotFullTree {
+		t.Error("Expected error enabling strict mode on a non-full tree")
+	}
+	tree.TINSERT(3, "")
+	if err := tree.SetStrictFull(true); err != nil {
+		t.Fatal(err)
+	}
+	if !tree.IsStrictFull() {
+		t.Error("Expected strict mode to be enabled")
+	}
+
+	tree.TINSERT(4, "four")
+	if tree.ISMEMBER(4) {
+		t.Error("Expected single insert to be ignored in strict mode")
+	}
+	tree.TINSERT(3, "three")
+	if val, _ := tree.TGET(3); val != "three" {
+		t.Errorf("Expected value update in strict mode, got '%s'", val)
+	}
+	tree.TDEL(2)
+	if !tree.ISMEMBER(2) {
+		t.Error("Expected single delete to be ignored in strict mode")
+	}
+
+	if err := tree.InsertPair(4, "a", 5, "b"); err != nil {
+		t.Fatal(err)
+	}
+	if err := tree.InsertPair(6, "c", 7, "d"); err != nil {
+		t.Fatal(err)
+	}
+	if tree.PRINT_BFS() != "1 2 3 4 5 6 7" || !tree.IsFull() {
+		t.Errorf("Unexpected tree after pair inserts '%s'", tree.PRINT_BFS())
+	}
+
+	if tree.InsertPair(8, "", 8, "") == nil {
+		t.Error("Expected error for equal keys")
+	}
+	if tree.InsertPair(1, "", 9, "") == nil {
+		t.Error("Expected error for existing key")
+	}
+}
+
+func TestFullBinaryTreeTryInsertDelete(t *testing.T) {
+	tree := NewFullBinaryTree()
+	if err := tree.TryInsert(1, "one"); err != nil {
+		t.Fatal(err)
+	}
+	if err := tree.TryDelete(42); err != ErrNotFound {
+		t.Errorf("Expected ErrNotFound for missing key, got %v", err)
+	}
+	tree.TINSERT(2, "two")
+	tree.TINSERT(3, "three")
+	if err := tree.SetStrictFull(true); err != nil {
+		t.Fatal(err)
+	}
+
+	before := tree.PRINT_BFS()
+	if err := tree.TryInsert(4, "four"); err != ErrNotFullTree {
+		t.Errorf("Expected ErrNotFullTree for strict insert, got %v", err)
+	}
+	if err := tree.TryDelete(2); err != ErrNotFullTree {
+		t.Errorf("Expected ErrNotFullTree for strict delete, got %v", err)
+	}
+	if tree.PRINT_BFS() != before || tree.ISMEMBER(4) || !tree.ISMEMBER(2) {
+		t.Errorf("Rejected calls changed the tree: '%s'", tree.PRINT_BFS())
+	}
+	if val, _ := tree.TGET(2); val != "two" {
+		t.Errorf("Expected value of 2 to stay 'two', got '%s'", val)
+	}
+
+	if err := tree.TryInsert(2, "TWO"); err != nil {
+		t.Errorf("Expected update of existing key in strict mode, got %v", err)
+	}
+	if tree.PRINT_BFS() != before {
+		t.Errorf("Update changed tree shape: '%s'", tree.PRINT_BFS())
+	}
+
+	tree.SetStrictFull(false)
+	if err := tree.TryDelete(2); err != nil || tree.ISMEMBER(2) {
+		t.Errorf("Expected delete outside strict mode, got %v", err)
+	}
+
+	single := NewFullBinaryTree()
+	single.SetStrictFull(true)
+	if err := single.TryInsert(1, ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := single.TryDelete(1); err != nil || single.ISMEMBER(1) {
+		t.Errorf("Expected lone root to be deleted in strict mode, got %v", err)
+	}
+}
+
+func TestFullBinaryTreeDeletePair(t *testing.T) {
+	tree := NewFullBinaryTree()
+	for i := 1; i <= 7; i++ {
+		tree.TINSERT(i, "v"+strconv.Itoa(i))
+	}
+	tree.SetStrictFull(true)
+
+	if err := tree.DeletePair(1, 6); err != nil {
+		t.Fatal(err)
+	}
+	if !tree.IsFull() || tree.Size() != 5 {
+		t.Errorf("Expected full tree of 5 nodes, got '%s'", tree.PRINT_BFS())
+	}
+	if tree.ISMEMBER(1) || tree.ISMEMBER(6) {
+		t.Error("Expected deleted keys to be gone")
+	}
+	for _, key := range []int{2, 3, 4, 5, 7} {
+		if val, ok := tree.TGET(key); !ok || val != "v"+strconv.Itoa(key) {
+			t.Errorf("Expected key %d with its value to survive, got '%s'", key, val)
+		}
+	}
+
+	if tree.DeletePair(2, 99) != ErrNotFound {
+		t.Error("Expected ErrNotFound for missing key")
+	}
+	if err := tree.DeletePair(2, 3); err != nil {
+		t.Fatal(err)
+	}
+	if err := tree.DeletePair(4, 5); err != nil {
+		t.Fatal(err)
+	}
+	if tree.Size() != 1 {
+		t.Errorf("Expected single node, got '%s'", tree.PRINT_BFS())
+	}
+	if tree.DeletePair(7, 8) == nil {
+		t.Error("Expected error deleting a pair from a single node")
+	}
+
+	tree.TDEL(7)
+	if tree.Size() != 0 {
+		t.Error("Expected strict mode to allow deleting a lone root")
+	}
+	if tree.InsertPair(1, "", 2, "") != ErrNotFullTree {
+		t.Error("Expected error inserting a pair into an empty tree")
+	}
+}
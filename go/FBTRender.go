@@ -0,0 +1,146 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// RenderSideways рисует дерево, повёрнутое на 90°: корень слева,
+// правое поддерево сверху, левое снизу
+func (fbt *FullBinaryTree) RenderSideways() string {
+	var sb strings.Builder
+	type frame struct {
+		node   *FBNode
+		prefix string
+		isLeft bool
+		isRoot bool
+		opened bool
+	}
+
+	if fbt.root == nil {
+		return ""
+	}
+
+	// обратный inorder (правое, узел, левое) на явном стеке
+	stack := []frame{{node: fbt.root, isRoot: true}}
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+		if !top.opened {
+			top.opened = true
+			if top.node.right != nil {
+				childPrefix := top.prefix
+				if !top.isRoot {
+					if top.isLeft {
+						childPrefix += "│   "
+					} else {
+						childPrefix += "    "
+					}
+				}
+				stack = append(stack, frame{node: top.node.right, prefix: childPrefix})
+			}
+			continue
+		}
+
+		current := *top
+		stack = stack[:len(stack)-1]
+
+		connector := ""
+		if !current.isRoot {
+			if current.isLeft {
+				connector = "└── "
+			} else {
+				connector = "┌── "
+			}
+		}
+		sb.WriteString(current.prefix + connector + strconv.Itoa(current.node.key) + "\n")
+
+		if current.node.left != nil {
+			childPrefix := current.prefix
+			if !current.isRoot {
+				if current.isLeft {
+					childPrefix += "    "
+				} else {
+					childPrefix += "│   "
+				}
+			}
+			stack = append(stack, frame{node: current.node.left, prefix: childPrefix, isLeft: true})
+		}
+	}
+	return sb.String()
+}
+
+// RenderTopDown рисует дерево сверху вниз: каждый ключ стоит над своими детьми,
+// а столбцы распределяются по порядку inorder
+func (fbt *FullBinaryTree) RenderTopDown() string {
+	if fbt.root == nil {
+		return ""
+	}
+
+	column := make(map[*FBNode]int)
+	width := 0
+	for node := range inorderNodes(fbt.root) {
+		column[node] = width
+		width += len(strconv.Itoa(node.key)) + 1
+	}
+
+	var sb strings.Builder
+	for _, level := range fbt.levelNodes() {
+		keys := []rune(strings.Repeat(" ", width))
+		edges := []rune(strings.Repeat(" ", width))
+		hasEdges := false
+		for _, node := range level {
+			label := strconv.Itoa(node.key)
+			copy(keys[column[node]:], []rune(label))
+			if node.left != nil {
+				edges[column[node.left]+len(strconv.Itoa(node.left.key))/2] = '/'
+				hasEdges = true
+			}
+			if node.right != nil {
+				edges[column[node.right]+len(strconv.Itoa(node.right.key))/2] = '\\'
+				hasEdges = true
+			}
+		}
+		sb.WriteString(strings.TrimRight(string(keys), " ") + "\n")
+		if hasEdges {
+			sb.WriteString(strings.TrimRight(string(edges), " ") + "\n")
+		}
+	}
+	return sb.String()
+}
+
+// WriteDOT выводит дерево в формате Graphviz DOT; при showNil
+// отсутствующие дети рисуются точками, чтобы было видно, левый ребёнок или правый
+func (fbt *FullBinaryTree) WriteDOT(w io.Writer, showNil bool) error {
+	var sb strings.Builder
+	sb.WriteString("digraph FullBinaryTree {\n")
+	sb.WriteString("\tnode [shape=circle];\n")
+
+	ids := make(map[*FBNode]int)
+	for node := range levelOrderNodes(fbt.root) {
+		ids[node] = len(ids)
+		fmt.Fprintf(&sb, "\tn%d [label=%q];\n", ids[node], strconv.Itoa(node.key))
+	}
+
+	nilCount := 0
+	for node := range levelOrderNodes(fbt.root) {
+		children := []struct {
+			child *FBNode
+			side  string
+		}{{node.left, "L"}, {node.right, "R"}}
+		for _, c := range children {
+			if c.child != nil {
+				fmt.Fprintf(&sb, "\tn%d -> n%d [label=%q];\n", ids[node], ids[c.child], c.side)
+			} else if showNil {
+				fmt.Fprintf(&sb, "\tnil%d [shape=point];\n", nilCount)
+				fmt.Fprintf(&sb, "\tn%d -> nil%d [label=%q];\n", ids[node], nilCount, c.side)
+				nilCount++
+			}
+		}
+	}
+	sb.WriteString("}\n")
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
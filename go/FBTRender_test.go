@@ -0,0 +1,74 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestFullBinaryTreeRenderSideways(t *testing.T) {
+	tree := NewFullBinaryTree()
+	for i := 1; i <= 5; i++ {
+		tree.TINSERT(i, "")
+	}
+
+	expected := "┌── 3\n" +
+		"1\n" +
+		"│   ┌── 5\n" +
+		"└── 2\n" +
+		"    └── 4\n"
+	if got := tree.RenderSideways(); got != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, got)
+	}
+
+	if NewFullBinaryTree().RenderSideways() != "" {
+		t.Errorf("Expected empty rendering for empty tree")
+	}
+}
+
+func TestFullBinaryTreeRenderTopDown(t *testing.T) {
+	tree := newSevenNodeTree()
+
+	expected := "      1\n" +
+		"  /       \\\n" +
+		"  2       3\n" +
+		"/   \\   /   \\\n" +
+		"4   5   6   7\n"
+	if got := tree.RenderTopDown(); got != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, got)
+	}
+}
+
+func TestFullBinaryTreeWriteDOT(t *testing.T) {
+	tree := NewFullBinaryTree()
+	for i := 1; i <= 3; i++ {
+		tree.TINSERT(i, "")
+	}
+
+	var buf bytes.Buffer
+	if err := tree.WriteDOT(&buf, false); err != nil {
+		t.Fatalf("WriteDOT failed: %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"digraph FullBinaryTree {",
+		"n0 [label=\"1\"];",
+		"n0 -> n1 [label=\"L\"];",
+		"n0 -> n2 [label=\"R\"];",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected DOT output to contain '%s', got:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "nil") {
+		t.Errorf("Expected no nil leaves without showNil")
+	}
+
+	buf.Reset()
+	if err := tree.WriteDOT(&buf, true); err != nil {
+		t.Fatalf("WriteDOT failed: %v", err)
+	}
+	if got := strings.Count(buf.String(), "[shape=point]"); got != 4 {
+		t.Errorf("Expected 4 nil leaves, got %d", got)
+	}
+}
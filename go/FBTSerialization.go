@@ -0,0 +1,418 @@
+package main
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// fbtFormatMarker открывает файл формата с версией; в старом формате
+// первым записан неотрицательный размер, поэтому форматы не пересекаются
+const fbtFormatMarker int32 = -1
+
+// fbtFormatKeyValue — версия формата, в которой вместе с ключом хранится значение
+const fbtFormatKeyValue int32 = 1
+
+// fbtFormatShape — версия формата, в которой после каждого узла записан байт
+// с флагами детей, поэтому дерево любой формы восстанавливается без изменений
+const fbtFormatShape int32 = 2
+
+// fbtFormatWideKeys — версия формата с ключами int64 вместо int32
+const fbtFormatWideKeys int32 = 3
+
+// fbtFormatLegacy обозначает самый первый формат: размер и ключи int32 без маркера
+const fbtFormatLegacy int32 = 0
+
+const (
+	fbtHasLeft  byte = 1
+	fbtHasRight byte = 2
+)
+
+var errCorruptedTree = errors.New("corrupted tree file")
+
+// fbtMaxPrealloc ограничивает память, выделяемую заранее по размеру из заголовка
+const fbtMaxPrealloc = 1024
+
+// corruptOnEOF превращает преждевременный конец файла в errCorruptedTree
+func corruptOnEOF(err error) error {
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return errCorruptedTree
+	}
+	return err
+}
+
+func childFlags(node *FBNode) byte {
+	var flags byte
+	if node.left != nil {
+		flags |= fbtHasLeft
+	}
+	if node.right != nil {
+		flags |= fbtHasRight
+	}
+	return flags
+}
+
+func (fbt *FullBinaryTree) bfsForSerialization(node *FBNode, result *[]*FBNode) {
+	for current := range levelOrderNodes(node) {
+		*result = append(*result, current)
+	}
+}
+
+// buildCompleteTree восстанавливает дерево из старых форматов,
+// в которых форма не хранилась и дерево считалось полным слева направо
+func (fbt *FullBinaryTree) buildCompleteTree(nodes []FBNode, index int) *FBNode {
+	if index >= len(nodes) {
+		return nil
+	}
+
+	node := &FBNode{key: nodes[index].key, value: nodes[index].value}
+	node.left = fbt.buildCompleteTree(nodes, 2*index+1)
+	node.right = fbt.buildCompleteTree(nodes, 2*index+2)
+
+	return node
+}
+
+// buildFromShape восстанавливает дерево по узлам в порядке BFS и флагам их детей
+func buildFromShape(nodes []FBNode, flags []byte) (*FBNode, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+
+	built := make([]*FBNode, 0, len(nodes))
+	built = append(built, &FBNode{key: nodes[0].key, value: nodes[0].value})
+	next := 1
+
+	attach := func() (*FBNode, error) {
+		if next >= len(nodes) {
+			return nil, errCorruptedTree
+		}
+		child := &FBNode{key: nodes[next].key, value: nodes[next].value}
+		next++
+		built = append(built, child)
+		return child, nil
+	}
+
+	var err error
+	for i := 0; i < len(built); i++ {
+		if flags[i]&^(fbtHasLeft|fbtHasRight) != 0 {
+			return nil, errCorruptedTree
+		}
+		if flags[i]&fbtHasLeft != 0 {
+			if built[i].left, err = attach(); err != nil {
+				return nil, err
+			}
+		}
+		if flags[i]&fbtHasRight != 0 {
+			if built[i].right, err = attach(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if next != len(nodes) {
+		return nil, errCorruptedTree
+	}
+	return built[0], nil
+}
+
+// setLoadedRoot заменяет дерево загруженным; в строгом режиме неполное дерево отвергается
+func (fbt *FullBinaryTree) setLoadedRoot(root *FBNode) error {
+	if fbt.strictFull {
+		loaded := &FullBinaryTree{root: root}
+		if !loaded.IsFull() {
+			return ErrNotFullTree
+		}
+	}
+	fbt.root = root
+	return nil
+}
+
+// SaveToBinary пишет дерево в текущем формате с 64-битными ключами
+func (fbt *FullBinaryTree) SaveToBinary(filename string) error {
+	return fbt.SaveToBinaryVersion(filename, fbtFormatWideKeys)
+}
+
+// SaveToBinaryVersion пишет дерево в указанной версии формата, например для
+// программ, которые понимают только старые файлы. Версия fbtFormatLegacy —
+// самый первый формат без маркера. Форматы до fbtFormatWideKeys хранят ключи
+// в int32, поэтому ключ вне этого диапазона — ошибка, а не тихое усечение;
+// форматы до fbtFormatShape не хранят форму и при загрузке достраивают дерево
+// до полного слева направо.
+func (fbt *FullBinaryTree) SaveToBinaryVersion(filename string, version int32) error {
+	if version < fbtFormatLegacy || version > fbtFormatWideKeys {
+		return fmt.Errorf("unsupported tree format version %d", version)
+	}
+
+	nodes := make([]*FBNode, 0)
+	fbt.bfsForSerialization(fbt.root, &nodes)
+
+	if version < fbtFormatWideKeys {
+		for _, node := range nodes {
+			if node.key < math.MinInt32 || node.key > math.MaxInt32 {
+				return fmt.Errorf("key %d does not fit in tree format version %d", node.key, version)
+			}
+		}
+	}
+
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	writer := bufio.NewWriter(file)
+	header := []int32{int32(len(nodes))}
+	if version != fbtFormatLegacy {
+		header = []int32{fbtFormatMarker, version, int32(len(nodes))}
+	}
+	err = binary.Write(writer, binary.LittleEndian, header)
+	if err != nil {
+		return err
+	}
+
+	for _, node := range nodes {
+		if version >= fbtFormatWideKeys {
+			err = binary.Write(writer, binary.LittleEndian, int64(node.key))
+		} else {
+			err = binary.Write(writer, binary.LittleEndian, int32(node.key))
+		}
+		if err != nil {
+			return err
+		}
+
+		if version >= fbtFormatKeyValue {
+			strBytes := []byte(node.value)
+			err = binary.Write(writer, binary.LittleEndian, int32(len(strBytes)))
+			if err != nil {
+				return err
+			}
+			_, err = writer.Write(strBytes)
+			if err != nil {
+				return err
+			}
+		}
+
+		if version >= fbtFormatShape {
+			err = writer.WriteByte(childFlags(node))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return writer.Flush()
+}
+
+// LoadFromBinary читает текущий формат и все старые версии, включая самый
+// первый формат только с ключами
+func (fbt *FullBinaryTree) LoadFromBinary(filename string) error {
+	file, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+
+	var size int32
+	err = binary.Read(reader, binary.LittleEndian, &size)
+	if err != nil {
+		return err
+	}
+
+	version := fbtFormatLegacy
+	if size == fbtFormatMarker {
+		err = binary.Read(reader, binary.LittleEndian, &version)
+		if err != nil {
+			return err
+		}
+		if version < fbtFormatKeyValue || version > fbtFormatWideKeys {
+			return fmt.Errorf("unsupported tree format version %d", version)
+		}
+
+		err = binary.Read(reader, binary.LittleEndian, &size)
+		if err != nil {
+			return err
+		}
+	}
+	if size < 0 {
+		return errCorruptedTree
+	}
+
+	// размер из заголовка не доверяем: срезы растут по мере чтения,
+	// а короткий файл заканчивается ошибкой errCorruptedTree
+	nodes := make([]FBNode, 0, min(int(size), fbtMaxPrealloc))
+	flags := make([]byte, 0, min(int(size), fbtMaxPrealloc))
+	for i := 0; i < int(size); i++ {
+		var node FBNode
+		var flag byte
+		if version >= fbtFormatWideKeys {
+			var key int64
+			err = binary.Read(reader, binary.LittleEndian, &key)
+			if err != nil {
+				return corruptOnEOF(err)
+			}
+			if int64(int(key)) != key {
+				return fmt.Errorf("key %d does not fit in int", key)
+			}
+			node.key = int(key)
+		} else {
+			var key int32
+			err = binary.Read(reader, binary.LittleEndian, &key)
+			if err != nil {
+				return corruptOnEOF(err)
+			}
+			node.key = int(key)
+		}
+
+		if version >= fbtFormatKeyValue {
+			var strLen int32
+			err = binary.Read(reader, binary.LittleEndian, &strLen)
+			if err != nil {
+				return corruptOnEOF(err)
+			}
+			if strLen < 0 {
+				return errCorruptedTree
+			}
+			strBytes, err := io.ReadAll(io.LimitReader(reader, int64(strLen)))
+			if err != nil {
+				return err
+			}
+			if len(strBytes) != int(strLen) {
+				return errCorruptedTree
+			}
+			node.value = string(strBytes)
+		}
+
+		if version >= fbtFormatShape {
+			flag, err = reader.ReadByte()
+			if err != nil {
+				return corruptOnEOF(err)
+			}
+		}
+		nodes = append(nodes, node)
+		flags = append(flags, flag)
+	}
+
+	if version < fbtFormatShape {
+		return fbt.setLoadedRoot(fbt.buildCompleteTree(nodes, 0))
+	}
+	root, err := buildFromShape(nodes, flags)
+	if err != nil {
+		return err
+	}
+	return fbt.setLoadedRoot(root)
+}
+
+// shapeToken кодирует флаги детей для текстового формата: "LR", "L-", "-R" или "--"
+func shapeToken(flags byte) string {
+	token := []byte("--")
+	if flags&fbtHasLeft != 0 {
+		token[0] = 'L'
+	}
+	if flags&fbtHasRight != 0 {
+		token[1] = 'R'
+	}
+	return string(token)
+}
+
+func parseShapeToken(token string) (byte, error) {
+	if len(token) != 2 {
+		return 0, errCorruptedTree
+	}
+	var flags byte
+	switch token[0] {
+	case 'L':
+		flags |= fbtHasLeft
+	case '-':
+	default:
+		return 0, errCorruptedTree
+	}
+	switch token[1] {
+	case 'R':
+		flags |= fbtHasRight
+	case '-':
+	default:
+		return 0, errCorruptedTree
+	}
+	return flags, nil
+}
+
+// SaveToText пишет размер, а затем по строке на узел в порядке BFS:
+// ключ, флаги детей и значение, например "5 LR five"
+func (fbt *FullBinaryTree) SaveToText(filename string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	nodes := make([]*FBNode, 0)
+	fbt.bfsForSerialization(fbt.root, &nodes)
+
+	writer := bufio.NewWriter(file)
+	fmt.Fprintf(writer, "%d\n", len(nodes))
+	for _, node := range nodes {
+		fmt.Fprintf(writer, "%d %s %s\n", node.key, shapeToken(childFlags(node)), node.value)
+	}
+	return writer.Flush()
+}
+
+func (fbt *FullBinaryTree) LoadFromText(filename string) error {
+	file, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return err
+		}
+		return errCorruptedTree
+	}
+
+	size, err := strconv.Atoi(scanner.Text())
+	if err != nil {
+		return err
+	}
+	if size < 0 {
+		return errCorruptedTree
+	}
+
+	nodes := make([]FBNode, 0, min(size, fbtMaxPrealloc))
+	flags := make([]byte, 0, min(size, fbtMaxPrealloc))
+	for i := 0; i < size; i++ {
+		if !scanner.Scan() {
+			if err := scanner.Err(); err != nil {
+				return err
+			}
+			return errCorruptedTree
+		}
+		parts := strings.SplitN(scanner.Text(), " ", 3)
+		if len(parts) != 3 {
+			return errCorruptedTree
+		}
+		key, err := strconv.Atoi(parts[0])
+		if err != nil {
+			return err
+		}
+		flag, err := parseShapeToken(parts[1])
+		if err != nil {
+			return err
+		}
+		nodes = append(nodes, FBNode{key: key, value: parts[2]})
+		flags = append(flags, flag)
+	}
+
+	root, err := buildFromShape(nodes, flags)
+	if err != nil {
+		return err
+	}
+	return fbt.setLoadedRoot(root)
+}
@@ -0,0 +1,276 @@
+package main
+
+import (
+	"encoding/binary"
+	"math"
+	"os"
+	"strconv"
+	"testing"
+)
+
+// newGappedTree строит дерево, которое insert никогда не создаст:
+//
+//	  1
+//	 / \
+//	2   3
+//	 \   \
+//	  4   5
+//	 /
+//	6
+func newGappedTree() *FullBinaryTree {
+	six := &FBNode{key: 6, value: "six"}
+	four := &FBNode{key: 4, value: "four", left: six}
+	five := &FBNode{key: 5, value: "five with spaces"}
+	two := &FBNode{key: 2, value: "", right: four}
+	three := &FBNode{key: 3, value: "three", right: five}
+	return &FullBinaryTree{root: &FBNode{key: 1, value: "one", left: two, right: three}}
+}
+
+func assertSameShape(t *testing.T, expected, got *FullBinaryTree) {
+	t.Helper()
+	if got.PRINT_PREORDER() != expected.PRINT_PREORDER() || got.PRINT_INORDER() != expected.PRINT_INORDER() {
+		t.Errorf("Expected preorder '%s' and inorder '%s', got '%s' and '%s'",
+			expected.PRINT_PREORDER(), expected.PRINT_INORDER(), got.PRINT_PREORDER(), got.PRINT_INORDER())
+	}
+	for node := range preorderNodes(expected.root) {
+		if val, ok := got.TGET(node.key); !ok || val != node.value {
+			t.Errorf("Expected value '%s' for %d, got '%s'", node.value, node.key, val)
+		}
+	}
+}
+
+func TestFullBinaryTreeBinaryPreservesShape(t *testing.T) {
+	tree := newGappedTree()
+	if err := tree.SaveToBinary("fulltree_test.bin"); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove("fulltree_test.bin")
+
+	loaded := NewFullBinaryTree()
+	if err := loaded.LoadFromBinary("fulltree_test.bin"); err != nil {
+		t.Fatal(err)
+	}
+	assertSameShape(t, tree, loaded)
+}
+
+func TestFullBinaryTreeTextPreservesShape(t *testing.T) {
+	tree := newGappedTree()
+	if err := tree.SaveToText("fulltree_test.txt"); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove("fulltree_test.txt")
+
+	content, _ := os.ReadFile("fulltree_test.txt")
+	expected := "6\n1 LR one\n2 -R \n3 -R three\n4 L- four\n5 -- five with spaces\n6 -- six\n"
+	if string(content) != expected {
+		t.Errorf("Expected file:\n%s\ngot:\n%s", expected, content)
+	}
+
+	loaded := NewFullBinaryTree()
+	if err := loaded.LoadFromText("fulltree_test.txt"); err != nil {
+		t.Fatal(err)
+	}
+	assertSameShape(t, tree, loaded)
+}
+
+func TestFullBinaryTreeTextEmpty(t *testing.T) {
+	tree := NewFullBinaryTree()
+	if err := tree.SaveToText("fulltree_test.txt"); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove("fulltree_test.txt")
+
+	loaded := newSevenNodeTree()
+	if err := loaded.LoadFromText("fulltree_test.txt"); err != nil {
+		t.Fatal(err)
+	}
+	if loaded.PRINT_BFS() != "" {
+		t.Errorf("Expected empty tree, got '%s'", loaded.PRINT_BFS())
+	}
+}
+
+func TestFullBinaryTreeLoadKeyValueVersion(t *testing.T) {
+	file, err := os.Create("fulltree_test.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	binary.Write(file, binary.LittleEndian, []int32{fbtFormatMarker, fbtFormatKeyValue, 3})
+	for _, key := range []int32{10, 20, 30} {
+		binary.Write(file, binary.LittleEndian, []int32{key, 1})
+		file.Write([]byte{'v'})
+	}
+	file.Close()
+	defer os.Remove("fulltree_test.bin")
+
+	tree := NewFullBinaryTree()
+	if err := tree.LoadFromBinary("fulltree_test.bin"); err != nil {
+		t.Fatal(err)
+	}
+	if tree.PRINT_BFS() != "10 20 30" {
+		t.Errorf("Expected BFS '10 20 30', got '%s'", tree.PRINT_BFS())
+	}
+	if val, _ := tree.TGET(30); val != "v" {
+		t.Errorf("Expected 'v', got '%s'", val)
+	}
+}
+
+func TestFullBinaryTreeLoadCorruptedShape(t *testing.T) {
+	cases := map[string]string{
+		"too few nodes":  "2\n1 LR a\n2 -- b\n",
+		"too many nodes": "3\n1 L- a\n2 -- b\n3 -- c\n",
+		"bad token":      "1\n1 XY a\n",
+		"missing line":   "2\n1 L- a\n",
+	}
+	for name, content := range cases {
+		os.WriteFile("fulltree_test.txt", []byte(content), 0644)
+		tree := newSevenNodeTree()
+		if tree.LoadFromText("fulltree_test.txt") == nil {
+			t.Errorf("%s: expected error", name)
+		}
+		if tree.PRINT_BFS() != "1 2 3 4 5 6 7" {
+			t.Errorf("%s: tree changed after failed load", name)
+		}
+	}
+	os.Remove("fulltree_test.txt")
+}
+
+func TestFullBinaryTreeLoadStrictRejectsNonFull(t *testing.T) {
+	if err := newGappedTree().SaveToBinary("fulltree_test.bin"); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove("fulltree_test.bin")
+
+	tree := newSevenNodeTree()
+	if err := tree.SetStrictFull(true); err != nil {
+		t.Fatal(err)
+	}
+	if err := tree.LoadFromBinary("fulltree_test.bin"); err != ErrNotFullTree {
+		t.Errorf("Expected ErrNotFullTree, got %v", err)
+	}
+	if tree.PRINT_BFS() != "1 2 3 4 5 6 7" {
+		t.Errorf("Tree changed after rejected load: '%s'", tree.PRINT_BFS())
+	}
+}
+
+func TestFullBinaryTreeWideKeys(t *testing.T) {
+	if strconv.IntSize < 64 {
+		t.Skip("int is 32-bit on this platform")
+	}
+	big := math.MaxInt32
+	big++
+	small := math.MinInt32
+	small--
+
+	tree := NewFullBinaryTree()
+	tree.TINSERT(big, "big")
+	tree.TINSERT(small, "small")
+	tree.TINSERT(7, "seven")
+
+	if err := tree.SaveToBinary("fulltree_test.bin"); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove("fulltree_test.bin")
+
+	loaded := NewFullBinaryTree()
+	if err := loaded.LoadFromBinary("fulltree_test.bin"); err != nil {
+		t.Fatal(err)
+	}
+	if !loaded.Equal(tree) {
+		t.Errorf("Expected BFS '%s', got '%s'", tree.PRINT_BFS(), loaded.PRINT_BFS())
+	}
+
+	os.Remove("fulltree_test.bin")
+	for _, version := range []int32{fbtFormatLegacy, fbtFormatKeyValue, fbtFormatShape} {
+		if tree.SaveToBinaryVersion("fulltree_test.bin", version) == nil {
+			t.Errorf("Expected overflow error for version %d", version)
+		}
+		if _, err := os.Stat("fulltree_test.bin"); !os.IsNotExist(err) {
+			t.Errorf("File should not be created when keys overflow version %d", version)
+		}
+	}
+}
+
+func TestFullBinaryTreeSaveOlderVersions(t *testing.T) {
+	tree := NewFullBinaryTree()
+	for i := 1; i <= 5; i++ {
+		tree.TINSERT(i, "v"+strconv.Itoa(i))
+	}
+	defer os.Remove("fulltree_test.bin")
+
+	for _, version := range []int32{fbtFormatLegacy, fbtFormatKeyValue, fbtFormatShape, fbtFormatWideKeys} {
+		if err := tree.SaveToBinaryVersion("fulltree_test.bin", version); err != nil {
+			t.Fatalf("version %d: %v", version, err)
+		}
+		loaded := NewFullBinaryTree()
+		if err := loaded.LoadFromBinary("fulltree_test.bin"); err != nil {
+			t.Fatalf("version %d: %v", version, err)
+		}
+		if loaded.PRINT_BFS() != "1 2 3 4 5" {
+			t.Errorf("version %d: expected BFS '1 2 3 4 5', got '%s'", version, loaded.PRINT_BFS())
+		}
+		val, _ := loaded.TGET(4)
+		if version == fbtFormatLegacy && val != "" {
+			t.Errorf("Expected no values in legacy format, got '%s'", val)
+		}
+		if version != fbtFormatLegacy && val != "v4" {
+			t.Errorf("version %d: expected 'v4', got '%s'", version, val)
+		}
+	}
+
+	if tree.SaveToBinaryVersion("fulltree_test.bin", 99) == nil {
+		t.Error("Expected error for unsupported version")
+	}
+}
+
+func TestFullBinaryTreeLoadOversizedHeader(t *testing.T) {
+	defer os.Remove("fulltree_test.txt")
+	defer os.Remove("fulltree_test.bin")
+
+	os.WriteFile("fulltree_test.txt", []byte("1000000000000000000\n1 -- a\n"), 0644)
+	tree := newSevenNodeTree()
+	if err := tree.LoadFromText("fulltree_test.txt"); err != errCorruptedTree {
+		t.Errorf("Expected errCorruptedTree for oversized text header, got %v", err)
+	}
+	if tree.PRINT_BFS() != "1 2 3 4 5 6 7" {
+		t.Errorf("Tree changed after failed load: '%s'", tree.PRINT_BFS())
+	}
+
+	headers := map[string][]int32{
+		"legacy":     {math.MaxInt32, 1},
+		"key value":  {fbtFormatMarker, fbtFormatKeyValue, math.MaxInt32, 1, 0},
+		"shape":      {fbtFormatMarker, fbtFormatShape, math.MaxInt32},
+		"wide keys":  {fbtFormatMarker, fbtFormatWideKeys, math.MaxInt32},
+		"long value": {fbtFormatMarker, fbtFormatKeyValue, 1, 5, math.MaxInt32},
+	}
+	for name, header := range headers {
+		file, err := os.Create("fulltree_test.bin")
+		if err != nil {
+			t.Fatal(err)
+		}
+		binary.Write(file, binary.LittleEndian, header)
+		file.Close()
+
+		if err := tree.LoadFromBinary("fulltree_test.bin"); err != errCorruptedTree {
+			t.Errorf("%s: expected errCorruptedTree, got %v", name, err)
+		}
+		if tree.PRINT_BFS() != "1 2 3 4 5 6 7" {
+			t.Errorf("%s: tree changed after failed load: '%s'", name, tree.PRINT_BFS())
+		}
+	}
+}
+
+func TestFullBinaryTreeLoadTruncatedBinary(t *testing.T) {
+	if err := newGappedTree().SaveToBinary("fulltree_test.bin"); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove("fulltree_test.bin")
+
+	content, _ := os.ReadFile("fulltree_test.bin")
+	for cut := 13; cut < len(content); cut += 5 {
+		os.WriteFile("fulltree_test.bin", content[:cut], 0644)
+		tree := NewFullBinaryTree()
+		if err := tree.LoadFromBinary("fulltree_test.bin"); err != errCorruptedTree {
+			t.Errorf("Expected errCorruptedTree for file cut at %d bytes, got %v", cut, err)
+		}
+	}
+}
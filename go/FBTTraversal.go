@@ -0,0 +1,159 @@
+package main
+
+import (
+	"iter"
+	"slices"
+)
+
+// Обходы ниже нерекурсивны: глубина вырожденного дерева ограничена
+// только памятью под явный стек, а не стеком горутины
+
+func preorderNodes(root *FBNode) iter.Seq[*FBNode] {
+	return func(yield func(*FBNode) bool) {
+		if root == nil {
+			return
+		}
+		stack := []*FBNode{root}
+		for len(stack) > 0 {
+			current := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if !yield(current) {
+				return
+			}
+			if current.right != nil {
+				stack = append(stack, current.right)
+			}
+			if current.left != nil {
+				stack = append(stack, current.left)
+			}
+		}
+	}
+}
+
+func inorderNodes(root *FBNode) iter.Seq[*FBNode] {
+	return func(yield func(*FBNode) bool) {
+		stack := make([]*FBNode, 0)
+		current := root
+		for current != nil || len(stack) > 0 {
+			for current != nil {
+				stack = append(stack, current)
+				current = current.left
+			}
+			current = stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if !yield(current) {
+				return
+			}
+			current = current.right
+		}
+	}
+}
+
+func postorderNodes(root *FBNode) iter.Seq[*FBNode] {
+	return func(yield func(*FBNode) bool) {
+		stack := make([]*FBNode, 0)
+		var lastVisited *FBNode
+		current := root
+		for current != nil || len(stack) > 0 {
+			if current != nil {
+				stack = append(stack, current)
+				current = current.left
+				continue
+			}
+			top := stack[len(stack)-1]
+			if top.right != nil && top.right != lastVisited {
+				current = top.right
+				continue
+			}
+			stack = stack[:len(stack)-1]
+			if !yield(top) {
+				return
+			}
+			lastVisited = top
+		}
+	}
+}
+
+func levelOrderNodes(root *FBNode) iter.Seq[*FBNode] {
+	return func(yield func(*FBNode) bool) {
+		if root == nil {
+			return
+		}
+		queue := []*FBNode{root}
+		for head := 0; head < len(queue); head++ {
+			current := queue[head]
+			if !yield(current) {
+				return
+			}
+			if current.left != nil {
+				queue = append(queue, current.left)
+			}
+			if current.right != nil {
+				queue = append(queue, current.right)
+			}
+		}
+	}
+}
+
+func nodeKeys(nodes iter.Seq[*FBNode]) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for node := range nodes {
+			if !yield(node.key) {
+				return
+			}
+		}
+	}
+}
+
+func (fbt *FullBinaryTree) Preorder() iter.Seq[int] {
+	return nodeKeys(preorderNodes(fbt.root))
+}
+
+func (fbt *FullBinaryTree) Inorder() iter.Seq[int] {
+	return nodeKeys(inorderNodes(fbt.root))
+}
+
+func (fbt *FullBinaryTree) Postorder() iter.Seq[int] {
+	return nodeKeys(postorderNodes(fbt.root))
+}
+
+func (fbt *FullBinaryTree) LevelOrder() iter.Seq[int] {
+	return nodeKeys(levelOrderNodes(fbt.root))
+}
+
+func (fbt *FullBinaryTree) PreorderKeys() []int {
+	return collectKeys(fbt.Preorder())
+}
+
+func (fbt *FullBinaryTree) InorderKeys() []int {
+	return collectKeys(fbt.Inorder())
+}
+
+func (fbt *FullBinaryTree) PostorderKeys() []int {
+	return collectKeys(fbt.Postorder())
+}
+
+func (fbt *FullBinaryTree) LevelOrderKeys() []int {
+	return collectKeys(fbt.LevelOrder())
+}
+
+// Levels возвращает ключи по уровням, начиная с корня
+func (fbt *FullBinaryTree) Levels() [][]int {
+	nodes := fbt.levelNodes()
+	levels := make([][]int, len(nodes))
+	for i, level := range nodes {
+		levels[i] = make([]int, len(level))
+		for j, node := range level {
+			levels[i][j] = node.key
+		}
+	}
+	return levels
+}
+
+func collectKeys(keys iter.Seq[int]) []int {
+	result := slices.Collect(keys)
+	if result == nil {
+		result = make([]int, 0)
+	}
+	return result
+}
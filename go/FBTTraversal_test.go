@@ -0,0 +1,137 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func newSevenNodeTree() *FullBinaryTree {
+	tree := NewFullBinaryTree()
+	for i := 1; i <= 7; i++ {
+		tree.TINSERT(i, "")
+	}
+	return tree
+}
+
+func TestFullBinaryTreeTraversalSlices(t *testing.T) {
+	tree := newSevenNodeTree()
+
+	cases := map[string]struct {
+		got  []int
+		want []int
+	}{
+		"preorder":   {tree.PreorderKeys(), []int{1, 2, 4, 5, 3, 6, 7}},
+		"inorder":    {tree.InorderKeys(), []int{4, 2, 5, 1, 6, 3, 7}},
+		"postorder":  {tree.PostorderKeys(), []int{4, 5, 2, 6, 7, 3, 1}},
+		"levelorder": {tree.LevelOrderKeys(), []int{1, 2, 3, 4, 5, 6, 7}},
+	}
+	for name, c := range cases {
+		if !slices.Equal(c.got, c.want) {
+			t.Errorf("%s: expected %v, got %v", name, c.want, c.got)
+		}
+	}
+
+	if tree.PRINT_POSTORDER() != "4 5 2 6 7 3 1" {
+		t.Errorf("Unexpected PRINT_POSTORDER '%s'", tree.PRINT_POSTORDER())
+	}
+}
+
+func TestFullBinaryTreeLevels(t *testing.T) {
+	tree := newSevenNodeTree()
+	tree.TINSERT(8, "")
+
+	levels := tree.Levels()
+	if len(levels) != 4 {
+		t.Fatalf("Expected 4 levels, got %d", len(levels))
+	}
+	if !slices.Equal(levels[1], []int{2, 3}) || !slices.Equal(levels[3], []int{8}) {
+		t.Errorf("Unexpected levels %v", levels)
+	}
+
+	empty := NewFullBinaryTree()
+	if len(empty.Levels()) != 0 || len(empty.InorderKeys()) != 0 {
+		t.Error("Expected no levels and keys for empty tree")
+	}
+	if empty.InorderKeys() == nil {
+		t.Error("Expected empty slice, not nil")
+	}
+}
+
+func TestFullBinaryTreeIteratorsEarlyBreak(t *testing.T) {
+	tree := newSevenNodeTree()
+
+	iterators := map[string]func() []int{
+		"preorder": func() []int {
+			seen := []int{}
+			for key := range tree.Preorder() {
+				seen = append(seen, key)
+				if len(seen) == 3 {
+					break
+				}
+			}
+			return seen
+		},
+		"inorder": func() []int {
+			seen := []int{}
+			for key := range tree.Inorder() {
+				seen = append(seen, key)
+				if len(seen) == 3 {
+					break
+				}
+			}
+			return seen
+		},
+		"postorder": func() []int {
+			seen := []int{}
+			for key := range tree.Postorder() {
+				seen = append(seen, key)
+				if len(seen) == 3 {
+					break
+				}
+			}
+			return seen
+		},
+		"levelorder": func() []int {
+			seen := []int{}
+			for key := range tree.LevelOrder() {
+				seen = append(seen, key)
+				if len(seen) == 3 {
+					break
+				}
+			}
+			return seen
+		},
+	}
+	want := map[string][]int{
+		"preorder":   {1, 2, 4},
+		"inorder":    {4, 2, 5},
+		"postorder":  {4, 5, 2},
+		"levelorder": {1, 2, 3},
+	}
+	for name, run := range iterators {
+		if got := run(); !slices.Equal(got, want[name]) {
+			t.Errorf("%s: expected %v, got %v", name, want[name], got)
+		}
+	}
+}
+
+func TestFullBinaryTreeDegenerateTraversal(t *testing.T) {
+	const depth = 200000
+	tree := NewFullBinaryTree()
+	tree.root = &FBNode{key: 0}
+	current := tree.root
+	for i := 1; i < depth; i++ {
+		current.left = &FBNode{key: i}
+		current = current.left
+	}
+
+	if len(tree.PreorderKeys()) != depth || len(tree.InorderKeys()) != depth || len(tree.PostorderKeys()) != depth {
+		t.Error("Expected every traversal to visit all nodes")
+	}
+	if !tree.ISMEMBER(depth - 1) {
+		t.Error("Expected deepest key to be found")
+	}
+	if tree.InorderKeys()[0] != depth-1 {
+		t.Errorf("Expected deepest key first in inorder, got %d", tree.InorderKeys()[0])
+	}
+}
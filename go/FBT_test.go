@@ -1,6 +1,7 @@
 package main
 
 import (
+	"encoding/binary"
 	"os"
 	"strconv"
 	"strings"
@@ -23,7 +24,7 @@ func getElements(str string) []int {
 
 func TestFullBinaryTreeInsertBasic(t *testing.T) {
 	tree := NewFullBinaryTree()
-	tree.TINSERT(10)
+	tree.TINSERT(10, strconv.Itoa(10))
 
 	if !tree.ISMEMBER(10) {
 		t.Error("Expected 10 to be in tree")
@@ -36,9 +37,9 @@ func TestFullBinaryTreeInsertBasic(t *testing.T) {
 
 func TestFullBinaryTreeInsertMultiple(t *testing.T) {
 	tree := NewFullBinaryTree()
-	tree.TINSERT(30)
-	tree.TINSERT(10)
-	tree.TINSERT(20)
+	tree.TINSERT(30, strconv.Itoa(30))
+	tree.TINSERT(10, strconv.Itoa(10))
+	tree.TINSERT(20, strconv.Itoa(20))
 
 	if !tree.ISMEMBER(30) {
 		t.Error("Expected 30 to be in tree")
@@ -59,9 +60,9 @@ func TestFullBinaryTreeInsertMultiple(t *testing.T) {
 
 func TestFullBinaryTreeDeleteLeaf(t *testing.T) {
 	tree := NewFullBinaryTree()
-	tree.TINSERT(1)
-	tree.TINSERT(2)
-	tree.TINSERT(3)
+	tree.TINSERT(1, strconv.Itoa(1))
+	tree.TINSERT(2, strconv.Itoa(2))
+	tree.TINSERT(3, strconv.Itoa(3))
 	tree.TDEL(3)
 
 	if tree.ISMEMBER(3) {
@@ -71,9 +72,9 @@ func TestFullBinaryTreeDeleteLeaf(t *testing.T) {
 
 func TestFullBinaryTreeDeleteRoot(t *testing.T) {
 	tree := NewFullBinaryTree()
-	tree.TINSERT(2)
-	tree.TINSERT(1)
-	tree.TINSERT(3)
+	tree.TINSERT(2, strconv.Itoa(2))
+	tree.TINSERT(1, strconv.Itoa(1))
+	tree.TINSERT(3, strconv.Itoa(3))
 	tree.TDEL(2)
 
 	if tree.ISMEMBER(2) {
@@ -89,9 +90,9 @@ func TestFullBinaryTreeDeleteRoot(t *testing.T) {
 
 func TestFullBinaryTreeAllTraversalsProduceSameElements(t *testing.T) {
 	tree := NewFullBinaryTree()
-	tree.TINSERT(3)
-	tree.TINSERT(1)
-	tree.TINSERT(2)
+	tree.TINSERT(3, strconv.Itoa(3))
+	tree.TINSERT(1, strconv.Itoa(1))
+	tree.TINSERT(2, strconv.Itoa(2))
 
 	inorder := getElements(tree.PRINT_INORDER())
 	preorder := getElements(tree.PRINT_PREORDER())
@@ -131,38 +132,38 @@ func TestFullBinaryTreeEmptyTree(t *testing.T) {
 		t.Errorf("Expected empty string for empty tree, got '%s'", tree.PRINT_INORDER())
 	}
 
-	if tree.TGET(999) != "" {
-		t.Errorf("Expected empty string for non-existent key, got '%s'", tree.TGET(999))
+	if _, ok := tree.TGET(999); ok {
+		t.Error("Expected TGET to report missing key in empty tree")
 	}
 }
 
 func TestFullBinaryTreeTGETMethod(t *testing.T) {
 	tree := NewFullBinaryTree()
-	tree.TINSERT(42)
-	tree.TINSERT(24)
-	tree.TINSERT(100)
+	tree.TINSERT(42, strconv.Itoa(42))
+	tree.TINSERT(24, strconv.Itoa(24))
+	tree.TINSERT(100, strconv.Itoa(100))
 
-	if tree.TGET(42) != "42" {
-		t.Errorf("Expected '42', got '%s'", tree.TGET(42))
+	if val, ok := tree.TGET(42); !ok || val != "42" {
+		t.Errorf("Expected '42', got '%s'", val)
 	}
-	if tree.TGET(24) != "24" {
-		t.Errorf("Expected '24', got '%s'", tree.TGET(24))
+	if val, ok := tree.TGET(24); !ok || val != "24" {
+		t.Errorf("Expected '24', got '%s'", val)
 	}
-	if tree.TGET(100) != "100" {
-		t.Errorf("Expected '100', got '%s'", tree.TGET(100))
+	if val, ok := tree.TGET(100); !ok || val != "100" {
+		t.Errorf("Expected '100', got '%s'", val)
 	}
-	if tree.TGET(999) != "" {
-		t.Errorf("Expected empty string for non-existent key, got '%s'", tree.TGET(999))
+	if val, ok := tree.TGET(999); ok || val != "" {
+		t.Errorf("Expected missing key, got '%s'", val)
 	}
 }
 
 func TestFullBinaryTreeSaveLoadBinary(t *testing.T) {
 	tree := NewFullBinaryTree()
-	tree.TINSERT(5)
-	tree.TINSERT(3)
-	tree.TINSERT(7)
-	tree.TINSERT(2)
-	tree.TINSERT(4)
+	tree.TINSERT(5, strconv.Itoa(5))
+	tree.TINSERT(3, strconv.Itoa(3))
+	tree.TINSERT(7, strconv.Itoa(7))
+	tree.TINSERT(2, strconv.Itoa(2))
+	tree.TINSERT(4, strconv.Itoa(4))
 
 	err := tree.SaveToBinary("fulltree_test.bin")
 	if err != nil {
@@ -200,9 +201,9 @@ func TestFullBinaryTreeSaveLoadBinary(t *testing.T) {
 
 func TestFullBinaryTreeClearTree(t *testing.T) {
 	tree := NewFullBinaryTree()
-	tree.TINSERT(1)
-	tree.TINSERT(2)
-	tree.TINSERT(3)
+	tree.TINSERT(1, strconv.Itoa(1))
+	tree.TINSERT(2, strconv.Itoa(2))
+	tree.TINSERT(3, strconv.Itoa(3))
 
 	if !tree.ISMEMBER(1) {
 		t.Error("Expected 1 to be in tree")
@@ -221,15 +222,15 @@ func TestFullBinaryTreeInsertManyElements(t *testing.T) {
 	tree := NewFullBinaryTree()
 
 	for i := 1; i <= 15; i++ {
-		tree.TINSERT(i)
+		tree.TINSERT(i, strconv.Itoa(i))
 	}
 
 	for i := 1; i <= 15; i++ {
 		if !tree.ISMEMBER(i) {
 			t.Errorf("Expected %d to be in tree", i)
 		}
-		if tree.TGET(i) != strconv.Itoa(i) {
-			t.Errorf("Expected '%d', got '%s'", i, tree.TGET(i))
+		if val, _ := tree.TGET(i); val != strconv.Itoa(i) {
+			t.Errorf("Expected '%d', got '%s'", i, val)
 		}
 	}
 
@@ -242,10 +243,10 @@ func TestFullBinaryTreeInsertManyElements(t *testing.T) {
 
 func TestFullBinaryTreeDeleteAndReinsert(t *testing.T) {
 	tree := NewFullBinaryTree()
-	tree.TINSERT(10)
-	tree.TINSERT(20)
-	tree.TINSERT(30)
-	tree.TINSERT(40)
+	tree.TINSERT(10, strconv.Itoa(10))
+	tree.TINSERT(20, strconv.Itoa(20))
+	tree.TINSERT(30, strconv.Itoa(30))
+	tree.TINSERT(40, strconv.Itoa(40))
 
 	tree.TDEL(20)
 	if tree.ISMEMBER(20) {
@@ -261,7 +262,7 @@ func TestFullBinaryTreeDeleteAndReinsert(t *testing.T) {
 		t.Error("Expected 40 to still be in tree")
 	}
 
-	tree.TINSERT(50)
+	tree.TINSERT(50, strconv.Itoa(50))
 	if !tree.ISMEMBER(50) {
 		t.Error("Expected 50 to be in tree")
 	}
@@ -275,8 +276,8 @@ func TestFullBinaryTreeDeleteAndReinsert(t *testing.T) {
 
 func TestFullBinaryTreeDeleteNonExisting(t *testing.T) {
 	tree := NewFullBinaryTree()
-	tree.TINSERT(1)
-	tree.TINSERT(2)
+	tree.TINSERT(1, strconv.Itoa(1))
+	tree.TINSERT(2, strconv.Itoa(2))
 	tree.TDEL(999)
 
 	if !tree.ISMEMBER(1) {
@@ -289,13 +290,13 @@ func TestFullBinaryTreeDeleteNonExisting(t *testing.T) {
 
 func TestFullBinaryTreeDeleteNodeWithTwoChildren(t *testing.T) {
 	tree := NewFullBinaryTree()
-	tree.TINSERT(10)
-	tree.TINSERT(5)
-	tree.TINSERT(15)
-	tree.TINSERT(3)
-	tree.TINSERT(7)
-	tree.TINSERT(12)
-	tree.TINSERT(20)
+	tree.TINSERT(10, strconv.Itoa(10))
+	tree.TINSERT(5, strconv.Itoa(5))
+	tree.TINSERT(15, strconv.Itoa(15))
+	tree.TINSERT(3, strconv.Itoa(3))
+	tree.TINSERT(7, strconv.Itoa(7))
+	tree.TINSERT(12, strconv.Itoa(12))
+	tree.TINSERT(20, strconv.Itoa(20))
 
 	tree.TDEL(5)
 
@@ -339,13 +340,13 @@ func TestFullBinaryTreeLoadFromNonExistingFile(t *testing.T) {
 
 func TestFullBinaryTreeSingleNodeTree(t *testing.T) {
 	tree := NewFullBinaryTree()
-	tree.TINSERT(100)
+	tree.TINSERT(100, strconv.Itoa(100))
 
 	if !tree.ISMEMBER(100) {
 		t.Error("Expected 100 to be in tree")
 	}
-	if tree.TGET(100) != "100" {
-		t.Errorf("Expected '100', got '%s'", tree.TGET(100))
+	if val, _ := tree.TGET(100); val != "100" {
+		t.Errorf("Expected '100', got '%s'", val)
 	}
 	if tree.PRINT_INORDER() != "100" {
 		t.Errorf("Expected '100', got '%s'", tree.PRINT_INORDER())
@@ -363,7 +364,7 @@ func TestFullBinaryTreeSingleNodeTree(t *testing.T) {
 func TestFullBinaryTreeMultipleDeleteOperations(t *testing.T) {
 	tree := NewFullBinaryTree()
 	for i := 1; i <= 7; i++ {
-		tree.TINSERT(i)
+		tree.TINSERT(i, strconv.Itoa(i))
 	}
 
 	tree.TDEL(4)
@@ -386,3 +387,91 @@ func TestFullBinaryTreeMultipleDeleteOperations(t *testing.T) {
 		}
 	}
 }
+
+func TestFullBinaryTreeValues(t *testing.T) {
+	tree := NewFullBinaryTree()
+	tree.TINSERT(1, "one")
+	tree.TINSERT(2, "two")
+	tree.TINSERT(3, "three")
+	tree.TINSERT(2, "TWO")
+
+	if tree.PRINT_BFS() != "1 2 3" {
+		t.Errorf("Expected no duplicate keys, got '%s'", tree.PRINT_BFS())
+	}
+	if val, ok := tree.TGET(2); !ok || val != "TWO" {
+		t.Errorf("Expected updated value 'TWO', got '%s'", val)
+	}
+
+	tree.TINSERT(4, "")
+	if val, ok := tree.TGET(4); !ok || val != "" {
+		t.Error("Expected empty value to be distinguishable from missing key")
+	}
+
+	tree.TDEL(1)
+	if val, _ := tree.TGET(4); val != "" {
+		t.Errorf("Expected value of 4 to survive deletion, got '%s'", val)
+	}
+	if val, _ := tree.TGET(3); val != "three" {
+		t.Errorf("Expected 'three', got '%s'", val)
+	}
+}
+
+func TestFullBinaryTreeSaveLoadValues(t *testing.T) {
+	tree := NewFullBinaryTree()
+	tree.TINSERT(5, "five")
+	tree.TINSERT(3, "three words here")
+	tree.TINSERT(7, "")
+
+	err := tree.SaveToBinary("fulltree_test.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tree2 := NewFullBinaryTree()
+	err = tree2.LoadFromBinary("fulltree_test.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if tree2.PRINT_BFS() != "5 3 7" {
+		t.Errorf("Expected BFS '5 3 7', got '%s'", tree2.PRINT_BFS())
+	}
+	if val, _ := tree2.TGET(3); val != "three words here" {
+		t.Errorf("Expected 'three words here', got '%s'", val)
+	}
+	if val, ok := tree2.TGET(7); !ok || val != "" {
+		t.Errorf("Expected empty value for 7, got '%s'", val)
+	}
+
+	os.Remove("fulltree_test.bin")
+}
+
+func TestFullBinaryTreeLoadLegacyBinary(t *testing.T) {
+	file, err := os.Create("fulltree_test.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	binary.Write(file, binary.LittleEndian, []int32{3, 10, 20, 30})
+	file.Close()
+
+	tree := NewFullBinaryTree()
+	err = tree.LoadFromBinary("fulltree_test.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tree.PRINT_BFS() != "10 20 30" {
+		t.Errorf("Expected BFS '10 20 30', got '%s'", tree.PRINT_BFS())
+	}
+	if val, ok := tree.TGET(20); !ok || val != "" {
+		t.Errorf("Expected empty value for legacy key, got '%s'", val)
+	}
+
+	file, _ = os.Create("fulltree_test.bin")
+	binary.Write(file, binary.LittleEndian, []int32{fbtFormatMarker, 99, 0})
+	file.Close()
+	if tree.LoadFromBinary("fulltree_test.bin") == nil {
+		t.Error("Expected error for unknown format version")
+	}
+
+	os.Remove("fulltree_test.bin")
+}
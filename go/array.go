@@ -5,7 +5,9 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io"
+	"math/rand"
 	"os"
+	"sort"
 	"strconv"
 )
 
@@ -54,7 +56,9 @@ func (a *Array) InsertAt(index int, value string) error {
 
 func (a *Array) PopBack() {
 	if a.size > 0 {
+		a.data[a.size-1] = ""
 		a.size--
+		a.shrink()
 	}
 }
 
@@ -65,7 +69,9 @@ func (a *Array) PopFront() {
 	for i := 0; i < a.size-1; i++ {
 		a.data[i] = a.data[i+1]
 	}
+	a.data[a.size-1] = ""
 	a.size--
+	a.shrink()
 }
 
 func (a *Array) RemoveAt(index int) error {
@@ -75,10 +81,57 @@ func (a *Array) RemoveAt(index int) error {
 	for i := index; i < a.size-1; i++ {
 		a.data[i] = a.data[i+1]
 	}
+	a.data[a.size-1] = ""
 	a.size--
+	a.shrink()
 	return nil
 }
 
+func (a *Array) AppendAll(values ...string) {
+	a.growFor(len(values))
+	copy(a.data[a.size:], values)
+	a.size += len(values)
+}
+
+func (a *Array) InsertAll(index int, values ...string) error {
+	if index < 0 || index > a.size {
+		return fmt.Errorf("index out of range")
+	}
+	a.growFor(len(values))
+	copy(a.data[index+len(values):], a.data[index:a.size])
+	copy(a.data[index:], values)
+	a.size += len(values)
+	return nil
+}
+
+// RemoveRange удаляет элементы в полуинтервале [from, to)
+func (a *Array) RemoveRange(from, to int) error {
+	if from < 0 || to > a.size || from > to {
+		return fmt.Errorf("index out of range")
+	}
+	copy(a.data[from:], a.data[to:a.size])
+	newSize := a.size - (to - from)
+	clear(a.data[newSize:a.size])
+	a.size = newSize
+	a.shrink()
+	return nil
+}
+
+// Slice возвращает копию элементов в полуинтервале [from, to)
+func (a *Array) Slice(from, to int) (*Array, error) {
+	if from < 0 || to > a.size || from > to {
+		return nil, fmt.Errorf("index out of range")
+	}
+	result := NewArray(to - from)
+	result.AppendAll(a.data[from:to]...)
+	return result, nil
+}
+
+func (a *Array) Clear() {
+	clear(a.data[:a.size])
+	a.size = 0
+}
+
 func (a *Array) Find(value string) int {
 	for i := 0; i < a.size; i++ {
 		if a.data[i] == value {
@@ -88,6 +141,50 @@ func (a *Array) Find(value string) int {
 	return -1
 }
 
+// BinarySearch ищет значение в массиве, отсортированном по возрастанию
+func (a *Array) BinarySearch(value string) int {
+	i := sort.SearchStrings(a.data[:a.size], value)
+	if i < a.size && a.data[i] == value {
+		return i
+	}
+	return -1
+}
+
+func (a *Array) Sort(less func(x, y string) bool) {
+	items := a.data[:a.size]
+	sort.Slice(items, func(i, j int) bool {
+		return less(items[i], items[j])
+	})
+}
+
+func (a *Array) SortStable(less func(x, y string) bool) {
+	items := a.data[:a.size]
+	sort.SliceStable(items, func(i, j int) bool {
+		return less(items[i], items[j])
+	})
+}
+
+func (a *Array) IsSorted(less func(x, y string) bool) bool {
+	for i := 1; i < a.size; i++ {
+		if less(a.data[i], a.data[i-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (a *Array) Reverse() {
+	for i, j := 0, a.size-1; i < j; i, j = i+1, j-1 {
+		a.data[i], a.data[j] = a.data[j], a.data[i]
+	}
+}
+
+func (a *Array) Shuffle(r *rand.Rand) {
+	r.Shuffle(a.size, func(i, j int) {
+		a.data[i], a.data[j] = a.data[j], a.data[i]
+	})
+}
+
 func (a *Array) Get(index int) (string, error) {
 	if index < 0 || index >= a.size {
 		return "", fmt.Errorf("index out of range")
@@ -130,6 +227,46 @@ func (a *Array) ensureCapacity() {
 	}
 }
 
+func (a *Array) Cap() int {
+	return len(a.data)
+}
+
+func (a *Array) Reserve(n int) {
+	if n > len(a.data) {
+		a.reallocate(n)
+	}
+}
+
+func (a *Array) ShrinkToFit() {
+	if len(a.data) != a.size {
+		a.reallocate(a.size)
+	}
+}
+
+func (a *Array) shrink() {
+	if newCapacity, ok := shrinkTarget(a.size, len(a.data)); ok {
+		a.reallocate(newCapacity)
+	}
+}
+
+func (a *Array) reallocate(newCapacity int) {
+	newData := make([]string, newCapacity)
+	copy(newData, a.data[:a.size])
+	a.data = newData
+}
+
+func (a *Array) growFor(extra int) {
+	needed := a.size + extra
+	if needed <= len(a.data) {
+		return
+	}
+	newCapacity := len(a.data) * 2
+	if newCapacity < needed {
+		newCapacity = needed
+	}
+	a.Reserve(newCapacity)
+}
+
 func (a *Array) SaveToText(filename string) error {
 	file, err := os.Create(filename)
 	if err != nil {
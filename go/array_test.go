@@ -1,6 +1,7 @@
 package main
 
 import (
+	"math/rand"
 	"os"
 	"testing"
 )
@@ -244,3 +245,177 @@ func TestArrayPrintMethod(t *testing.T) {
 	arr.PushBack("test2")
 	arr.Print()
 }
+
+func TestArrayBulkAppendInsert(t *testing.T) {
+	arr := NewArray(2)
+	arr.AppendAll("a", "b", "c", "d", "e")
+
+	if arr.GetSize() != 5 {
+		t.Errorf("Expected size 5, got %d", arr.GetSize())
+	}
+
+	err := arr.InsertAll(1, "x", "y")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"a", "x", "y", "b", "c", "d", "e"}
+	for i, want := range expected {
+		val, _ := arr.Get(i)
+		if val != want {
+			t.Errorf("Expected '%s' at %d, got '%s'", want, i, val)
+		}
+	}
+
+	err = arr.InsertAll(arr.GetSize()+1, "z")
+	if err == nil {
+		t.Error("Expected error for InsertAll past the end")
+	}
+}
+
+func TestArrayInsertAllFrontMany(t *testing.T) {
+	arr := NewArray(10)
+	arr.PushBack("tail")
+
+	values := make([]string, 10000)
+	for i := range values {
+		values[i] = "v"
+	}
+	arr.InsertAll(0, values...)
+
+	if arr.GetSize() != 10001 {
+		t.Errorf("Expected size 10001, got %d", arr.GetSize())
+	}
+	val, _ := arr.Get(10000)
+	if val != "tail" {
+		t.Errorf("Expected 'tail', got '%s'", val)
+	}
+}
+
+func TestArrayRemoveRangeAndSlice(t *testing.T) {
+	arr := NewArray(10)
+	arr.AppendAll("0", "1", "2", "3", "4", "5")
+
+	sub, err := arr.Slice(1, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sub.GetSize() != 3 {
+		t.Errorf("Expected slice size 3, got %d", sub.GetSize())
+	}
+	val, _ := sub.Get(0)
+	if val != "1" {
+		t.Errorf("Expected '1', got '%s'", val)
+	}
+
+	sub.Set(0, "changed")
+	val, _ = arr.Get(1)
+	if val != "1" {
+		t.Errorf("Slice must not share storage, got '%s'", val)
+	}
+
+	err = arr.RemoveRange(1, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if arr.GetSize() != 3 {
+		t.Errorf("Expected size 3, got %d", arr.GetSize())
+	}
+	val, _ = arr.Get(1)
+	if val != "4" {
+		t.Errorf("Expected '4', got '%s'", val)
+	}
+
+	if arr.RemoveRange(2, 1) == nil {
+		t.Error("Expected error for inverted range")
+	}
+	if _, err := arr.Slice(0, 10); err == nil {
+		t.Error("Expected error for Slice past the end")
+	}
+}
+
+func TestArrayReserveShrinkClear(t *testing.T) {
+	arr := NewArray(1)
+	arr.Reserve(100)
+	if arr.Cap() != 100 {
+		t.Errorf("Expected capacity 100, got %d", arr.Cap())
+	}
+
+	arr.AppendAll("a", "b")
+	arr.ShrinkToFit()
+	if arr.Cap() != 2 {
+		t.Errorf("Expected capacity 2, got %d", arr.Cap())
+	}
+
+	arr.Clear()
+	if arr.GetSize() != 0 {
+		t.Errorf("Expected size 0, got %d", arr.GetSize())
+	}
+
+	arr.PushBack("c")
+	val, _ := arr.Get(0)
+	if val != "c" {
+		t.Errorf("Expected 'c', got '%s'", val)
+	}
+}
+
+func TestArraySortAndBinarySearch(t *testing.T) {
+	arr := NewArray(10)
+	arr.AppendAll("d", "b", "a", "c")
+
+	less := func(x, y string) bool { return x < y }
+	if arr.IsSorted(less) {
+		t.Error("Expected unsorted array")
+	}
+
+	arr.Sort(less)
+	if !arr.IsSorted(less) {
+		t.Error("Expected sorted array")
+	}
+
+	if arr.BinarySearch("c") != 2 {
+		t.Errorf("Expected index 2 for 'c', got %d", arr.BinarySearch("c"))
+	}
+	if arr.BinarySearch("z") != -1 {
+		t.Errorf("Expected -1 for 'z', got %d", arr.BinarySearch("z"))
+	}
+}
+
+func TestArraySortStable(t *testing.T) {
+	arr := NewArray(10)
+	arr.AppendAll("bb", "a1", "cc", "a2")
+
+	byLength := func(x, y string) bool { return len(x) < len(y) }
+	byFirst := func(x, y string) bool { return x[0] < y[0] }
+	arr.SortStable(byLength)
+	arr.SortStable(byFirst)
+
+	expected := []string{"a1", "a2", "bb", "cc"}
+	for i, want := range expected {
+		val, _ := arr.Get(i)
+		if val != want {
+			t.Errorf("Expected '%s' at %d, got '%s'", want, i, val)
+		}
+	}
+}
+
+func TestArrayReverseShuffle(t *testing.T) {
+	arr := NewArray(10)
+	arr.AppendAll("1", "2", "3")
+	arr.Reverse()
+
+	val, _ := arr.Get(0)
+	if val != "3" {
+		t.Errorf("Expected '3', got '%s'", val)
+	}
+
+	arr.Shuffle(rand.New(rand.NewSource(1)))
+	if arr.GetSize() != 3 {
+		t.Errorf("Expected size 3, got %d", arr.GetSize())
+	}
+	for _, v := range []string{"1", "2", "3"} {
+		if arr.Find(v) == -1 {
+			t.Errorf("Expected '%s' to survive shuffle", v)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package main
+
+// AVLTree — дерево поиска, в котором высоты поддеревьев любого узла различаются не более чем на 1
+type AVLTree struct {
+	orderedTree
+}
+
+func NewAVLTree() *AVLTree {
+	return &AVLTree{}
+}
+
+func (t *AVLTree) TINSERT(key int, value string) {
+	var inserted bool
+	t.root, inserted = avlInsert(t.root, key, value)
+	if inserted {
+		t.size++
+	}
+}
+
+func (t *AVLTree) TDEL(key int) {
+	var removed bool
+	t.root, removed = avlDelete(t.root, key)
+	if removed {
+		t.size--
+	}
+}
+
+func avlHeight(node *OrderedNode) int {
+	if node == nil {
+		return 0
+	}
+	return node.height
+}
+
+func avlUpdate(node *OrderedNode) {
+	node.height = 1 + max(avlHeight(node.left), avlHeight(node.right))
+}
+
+func avlRotateRight(y *OrderedNode) *OrderedNode {
+	x := y.left
+	y.left = x.right
+	x.right = y
+	avlUpdate(y)
+	avlUpdate(x)
+	return x
+}
+
+func avlRotateLeft(x *OrderedNode) *OrderedNode {
+	y := x.right
+	x.right = y.left
+	y.left = x
+	avlUpdate(x)
+	avlUpdate(y)
+	return y
+}
+
+func avlBalance(node *OrderedNode) *OrderedNode {
+	avlUpdate(node)
+	factor := avlHeight(node.left) - avlHeight(node.right)
+	if factor > 1 {
+		if avlHeight(node.left.left) < avlHeight(node.left.right) {
+			node.left = avlRotateLeft(node.left)
+		}
+		return avlRotateRight(node)
+	}
+	if factor < -1 {
+		if avlHeight(node.right.right) < avlHeight(node.right.left) {
+			node.right = avlRotateRight(node.right)
+		}
+		return avlRotateLeft(node)
+	}
+	return node
+}
+
+func avlInsert(node *OrderedNode, key int, value string) (*OrderedNode, bool) {
+	if node == nil {
+		return &OrderedNode{key: key, value: value, height: 1}, true
+	}
+	var inserted bool
+	if key < node.key {
+		node.left, inserted = avlInsert(node.left, key, value)
+	} else if key > node.key {
+		node.right, inserted = avlInsert(node.right, key, value)
+	} else {
+		node.value = value
+		return node, false
+	}
+	return avlBalance(node), inserted
+}
+
+func avlDelete(node *OrderedNode, key int) (*OrderedNode, bool) {
+	if node == nil {
+		return nil, false
+	}
+	var removed bool
+	if key < node.key {
+		node.left, removed = avlDelete(node.left, key)
+	} else if key > node.key {
+		node.right, removed = avlDelete(node.right, key)
+	} else {
+		if node.left == nil {
+			return node.right, true
+		}
+		if node.right == nil {
+			return node.left, true
+		}
+		succ := minOrderedNode(node.right)
+		node.key = succ.key
+		node.value = succ.value
+		node.right, _ = avlDelete(node.right, succ.key)
+		removed = true
+	}
+	return avlBalance(node), removed
+}
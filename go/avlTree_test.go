@@ -0,0 +1,58 @@
+package main
+
+import (
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+func checkAVL(t *testing.T, node *OrderedNode) int {
+	if node == nil {
+		return 0
+	}
+	left := checkAVL(t, node.left)
+	right := checkAVL(t, node.right)
+	if left-right > 1 || right-left > 1 {
+		t.Fatalf("Node %d is unbalanced: %d vs %d", node.key, left, right)
+	}
+	height := 1 + max(left, right)
+	if node.height != height {
+		t.Fatalf("Node %d stores height %d, actual %d", node.key, node.height, height)
+	}
+	return height
+}
+
+func TestAVLTreeStaysBalanced(t *testing.T) {
+	tree := NewAVLTree()
+	for i := 0; i < 1024; i++ {
+		tree.TINSERT(i, strconv.Itoa(i))
+	}
+	if height := checkAVL(t, tree.root); height > 11 {
+		t.Errorf("Expected height at most 11 for sorted input, got %d", height)
+	}
+
+	r := rand.New(rand.NewSource(7))
+	for i := 0; i < 3000; i++ {
+		key := r.Intn(2048)
+		if r.Intn(2) == 0 {
+			tree.TDEL(key)
+		} else {
+			tree.TINSERT(key, strconv.Itoa(key))
+		}
+	}
+	checkAVL(t, tree.root)
+}
+
+func TestAVLTreeDeleteAll(t *testing.T) {
+	tree := NewAVLTree()
+	for i := 0; i < 100; i++ {
+		tree.TINSERT(i, strconv.Itoa(i))
+	}
+	for i := 99; i >= 0; i-- {
+		tree.TDEL(i)
+		checkAVL(t, tree.root)
+	}
+	if tree.root != nil || tree.GetSize() != 0 {
+		t.Error("Expected empty tree")
+	}
+}
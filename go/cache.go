@@ -0,0 +1,453 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+)
+
+type cacheEntry struct {
+	node      *DNode
+	expiresAt time.Time
+	freq      int
+}
+
+// cacheCore — общая часть LRU и LFU кэшей: значения хранятся в HashTable,
+// а entries хранит только служебные данные записи — узел DoublyList для
+// операций за O(1), срок истечения и частоту
+type cacheCore struct {
+	capacity int
+	ttl      time.Duration
+	values   *HashTable
+	entries  map[string]*cacheEntry
+	onEvict  func(key, value string)
+	hits     int
+	misses   int
+	now      func() time.Time
+}
+
+func newCacheCore(capacity int, ttl time.Duration) cacheCore {
+	if capacity <= 0 {
+		capacity = 10
+	}
+	return cacheCore{
+		capacity: capacity,
+		ttl:      ttl,
+		values:   NewHashTable(capacity),
+		entries:  make(map[string]*cacheEntry),
+		now:      time.Now,
+	}
+}
+
+func (c *cacheCore) reset() {
+	c.values = NewHashTable(c.capacity)
+	c.entries = make(map[string]*cacheEntry)
+}
+
+// SetEvictCallback задаёт функцию, вызываемую при вытеснении или истечении TTL
+func (c *cacheCore) SetEvictCallback(fn func(key, value string)) {
+	c.onEvict = fn
+}
+
+func (c *cacheCore) Stats() (hits, misses int) {
+	return c.hits, c.misses
+}
+
+// GetSize возвращает число неистёкших записей
+func (c *cacheCore) GetSize() int {
+	if c.ttl <= 0 {
+		return len(c.entries)
+	}
+	size := 0
+	for _, entry := range c.entries {
+		if !c.isExpired(entry) {
+			size++
+		}
+	}
+	return size
+}
+
+func (c *cacheCore) deadline() time.Time {
+	if c.ttl <= 0 {
+		return time.Time{}
+	}
+	return c.now().Add(c.ttl)
+}
+
+func (c *cacheCore) isExpired(entry *cacheEntry) bool {
+	return !entry.expiresAt.IsZero() && !c.now().Before(entry.expiresAt)
+}
+
+// expiredKeys возвращает ключи истёкших записей от раньше истёкших к позже
+func (c *cacheCore) expiredKeys() []string {
+	keys := make([]string, 0)
+	if c.ttl <= 0 {
+		return keys
+	}
+	for key, entry := range c.entries {
+		if c.isExpired(entry) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := c.entries[keys[i]].expiresAt, c.entries[keys[j]].expiresAt
+		if !a.Equal(b) {
+			return a.Before(b)
+		}
+		return keys[i] < keys[j]
+	})
+	return keys
+}
+
+func (c *cacheCore) notifyEvict(key, value string) {
+	if c.onEvict != nil {
+		c.onEvict(key, value)
+	}
+}
+
+func encodeExpiry(t time.Time) string {
+	if t.IsZero() {
+		return "0"
+	}
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
+
+func decodeExpiry(s string) (time.Time, error) {
+	nanos, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if nanos == 0 {
+		return time.Time{}, nil
+	}
+	return time.Unix(0, nanos), nil
+}
+
+type LRUCache struct {
+	cacheCore
+	order *DoublyList
+}
+
+// NewLRUCache создаёт кэш; ttl <= 0 отключает истечение записей
+func NewLRUCache(capacity int, ttl time.Duration) *LRUCache {
+	return &LRUCache{
+		cacheCore: newCacheCore(capacity, ttl),
+		order:     NewDoublyList(),
+	}
+}
+
+func (c *LRUCache) Get(key string) (string, bool) {
+	entry, ok := c.entries[key]
+	if !ok {
+		c.misses++
+		return "", false
+	}
+	if c.isExpired(entry) {
+		c.evict(key, entry)
+		c.misses++
+		return "", false
+	}
+	c.order.MoveToFront(entry.node)
+	c.hits++
+	return c.values.Get(key), true
+}
+
+func (c *LRUCache) Put(key, value string) {
+	if entry, ok := c.entries[key]; ok {
+		c.values.Put(key, value)
+		entry.expiresAt = c.deadline()
+		c.order.MoveToFront(entry.node)
+		return
+	}
+	c.insert(key, value, c.deadline())
+}
+
+func (c *LRUCache) Remove(key string) bool {
+	entry, ok := c.entries[key]
+	if !ok {
+		return false
+	}
+	c.removeEntry(key, entry)
+	return true
+}
+
+// PurgeExpired удаляет все истёкшие записи и возвращает их число
+func (c *LRUCache) PurgeExpired() int {
+	keys := c.expiredKeys()
+	for _, key := range keys {
+		c.evict(key, c.entries[key])
+	}
+	return len(keys)
+}
+
+// insert при заполненном кэше сначала убирает истёкшие записи
+// и вытесняет живую запись, только если места всё равно нет
+func (c *LRUCache) insert(key, value string, expiresAt time.Time) {
+	if len(c.entries) >= c.capacity {
+		c.PurgeExpired()
+	}
+	if len(c.entries) >= c.capacity {
+		oldest := c.order.Back()
+		c.evict(oldest.Value(), c.entries[oldest.Value()])
+	}
+	node := c.order.PushFront(key)
+	c.entries[key] = &cacheEntry{node: node, expiresAt: expiresAt, freq: 1}
+	c.values.Put(key, value)
+}
+
+func (c *LRUCache) evict(key string, entry *cacheEntry) {
+	value := c.values.Get(key)
+	c.removeEntry(key, entry)
+	c.notifyEvict(key, value)
+}
+
+func (c *LRUCache) removeEntry(key string, entry *cacheEntry) {
+	c.order.Remove(entry.node)
+	c.values.Remove(key)
+	delete(c.entries, key)
+}
+
+func (c *LRUCache) Clear() {
+	c.reset()
+	c.order.Clear()
+}
+
+// SaveToBinary сохраняет тройки ключ, значение, срок истечения
+// от давно использованных к недавним через DoublyList.SaveToBinary
+func (c *LRUCache) SaveToBinary(filename string) error {
+	list := NewDoublyList()
+	for node := c.order.Back(); node != nil; node = node.Prev() {
+		key := node.Value()
+		entry := c.entries[key]
+		list.PushBack(key)
+		list.PushBack(c.values.Get(key))
+		list.PushBack(encodeExpiry(entry.expiresAt))
+	}
+	return list.SaveToBinary(filename)
+}
+
+func (c *LRUCache) LoadFromBinary(filename string) error {
+	list := NewDoublyList()
+	err := list.LoadFromBinary(filename)
+	if err != nil {
+		return err
+	}
+	if list.GetSize()%3 != 0 {
+		return fmt.Errorf("corrupted cache file")
+	}
+
+	c.Clear()
+	for node := list.Front(); node != nil; node = node.Next().Next().Next() {
+		key := node.Value()
+		value := node.Next().Value()
+		expiresAt, err := decodeExpiry(node.Next().Next().Value())
+		if err != nil {
+			return err
+		}
+		if !expiresAt.IsZero() && !c.now().Before(expiresAt) {
+			continue
+		}
+		c.insert(key, value, expiresAt)
+	}
+	return nil
+}
+
+// LFUCache вытесняет наименее часто используемую запись,
+// а среди равных по частоте — наименее недавно использованную
+type LFUCache struct {
+	cacheCore
+	buckets map[int]*DoublyList
+	minFreq int
+}
+
+// NewLFUCache создаёт кэш; ttl <= 0 отключает истечение записей
+func NewLFUCache(capacity int, ttl time.Duration) *LFUCache {
+	return &LFUCache{
+		cacheCore: newCacheCore(capacity, ttl),
+		buckets:   make(map[int]*DoublyList),
+	}
+}
+
+func (c *LFUCache) Get(key string) (string, bool) {
+	entry, ok := c.entries[key]
+	if !ok {
+		c.misses++
+		return "", false
+	}
+	if c.isExpired(entry) {
+		c.evict(key, entry)
+		c.misses++
+		return "", false
+	}
+	c.touch(key, entry)
+	c.hits++
+	return c.values.Get(key), true
+}
+
+func (c *LFUCache) Put(key, value string) {
+	if entry, ok := c.entries[key]; ok {
+		c.values.Put(key, value)
+		entry.expiresAt = c.deadline()
+		c.touch(key, entry)
+		return
+	}
+	c.insert(key, value, c.deadline(), 1)
+}
+
+func (c *LFUCache) Remove(key string) bool {
+	entry, ok := c.entries[key]
+	if !ok {
+		return false
+	}
+	c.removeEntry(key, entry)
+	return true
+}
+
+// Frequency возвращает число обращений к ключу или 0, если его нет
+func (c *LFUCache) Frequency(key string) int {
+	entry, ok := c.entries[key]
+	if !ok {
+		return 0
+	}
+	return entry.freq
+}
+
+func (c *LFUCache) bucket(freq int) *DoublyList {
+	list, ok := c.buckets[freq]
+	if !ok {
+		list = NewDoublyList()
+		c.buckets[freq] = list
+	}
+	return list
+}
+
+func (c *LFUCache) unlinkFromBucket(entry *cacheEntry) {
+	list := c.buckets[entry.freq]
+	list.Remove(entry.node)
+	if list.GetSize() == 0 {
+		delete(c.buckets, entry.freq)
+	}
+}
+
+func (c *LFUCache) touch(key string, entry *cacheEntry) {
+	c.unlinkFromBucket(entry)
+	if entry.freq == c.minFreq && c.buckets[entry.freq] == nil {
+		c.minFreq++
+	}
+	entry.freq++
+	entry.node = c.bucket(entry.freq).PushBack(key)
+}
+
+// PurgeExpired удаляет все истёкшие записи и возвращает их число
+func (c *LFUCache) PurgeExpired() int {
+	keys := c.expiredKeys()
+	for _, key := range keys {
+		c.evict(key, c.entries[key])
+	}
+	return len(keys)
+}
+
+// insert при заполненном кэше сначала убирает истёкшие записи
+// и вытесняет живую запись, только если места всё равно нет
+func (c *LFUCache) insert(key, value string, expiresAt time.Time, freq int) {
+	if len(c.entries) >= c.capacity {
+		c.PurgeExpired()
+	}
+	if len(c.entries) >= c.capacity {
+		c.evictOne()
+	}
+	node := c.bucket(freq).PushBack(key)
+	c.entries[key] = &cacheEntry{node: node, expiresAt: expiresAt, freq: freq}
+	c.values.Put(key, value)
+	if len(c.entries) == 1 || freq < c.minFreq {
+		c.minFreq = freq
+	}
+}
+
+func (c *LFUCache) evictOne() {
+	list, ok := c.buckets[c.minFreq]
+	if !ok {
+		// minFreq устаревает после Remove — ищем наименьшую частоту заново
+		c.minFreq = 0
+		for freq := range c.buckets {
+			if c.minFreq == 0 || freq < c.minFreq {
+				c.minFreq = freq
+			}
+		}
+		list = c.buckets[c.minFreq]
+	}
+	key := list.Front().Value()
+	c.evict(key, c.entries[key])
+}
+
+func (c *LFUCache) evict(key string, entry *cacheEntry) {
+	value := c.values.Get(key)
+	c.removeEntry(key, entry)
+	c.notifyEvict(key, value)
+}
+
+func (c *LFUCache) removeEntry(key string, entry *cacheEntry) {
+	c.unlinkFromBucket(entry)
+	c.values.Remove(key)
+	delete(c.entries, key)
+}
+
+func (c *LFUCache) Clear() {
+	c.reset()
+	c.buckets = make(map[int]*DoublyList)
+	c.minFreq = 0
+}
+
+// SaveToBinary сохраняет четвёрки ключ, значение, срок истечения, частота
+// по возрастанию частоты через DoublyList.SaveToBinary
+func (c *LFUCache) SaveToBinary(filename string) error {
+	freqs := make([]int, 0, len(c.buckets))
+	for freq := range c.buckets {
+		freqs = append(freqs, freq)
+	}
+	sort.Ints(freqs)
+
+	list := NewDoublyList()
+	for _, freq := range freqs {
+		for node := c.buckets[freq].Front(); node != nil; node = node.Next() {
+			key := node.Value()
+			entry := c.entries[key]
+			list.PushBack(key)
+			list.PushBack(c.values.Get(key))
+			list.PushBack(encodeExpiry(entry.expiresAt))
+			list.PushBack(strconv.Itoa(freq))
+		}
+	}
+	return list.SaveToBinary(filename)
+}
+
+func (c *LFUCache) LoadFromBinary(filename string) error {
+	list := NewDoublyList()
+	err := list.LoadFromBinary(filename)
+	if err != nil {
+		return err
+	}
+	if list.GetSize()%4 != 0 {
+		return fmt.Errorf("corrupted cache file")
+	}
+
+	c.Clear()
+	for node := list.Front(); node != nil; node = node.Next().Next().Next().Next() {
+		key := node.Value()
+		value := node.Next().Value()
+		expiresAt, err := decodeExpiry(node.Next().Next().Value())
+		if err != nil {
+			return err
+		}
+		freq, err := strconv.Atoi(node.Next().Next().Next().Value())
+		if err != nil {
+			return err
+		}
+		if !expiresAt.IsZero() && !c.now().Before(expiresAt) {
+			continue
+		}
+		c.insert(key, value, expiresAt, freq)
+	}
+	return nil
+}
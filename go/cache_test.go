@@ -0,0 +1,316 @@
+package main
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestLRUCacheGetPutEviction(t *testing.T) {
+	cache := NewLRUCache(2, 0)
+	evicted := make([]string, 0)
+	cache.SetEvictCallback(func(key, value string) {
+		evicted = append(evicted, key+"="+value)
+	})
+
+	cache.Put("a", "1")
+	cache.Put("b", "2")
+	cache.Get("a")
+	cache.Put("c", "3")
+
+	if _, ok := cache.Get("b"); ok {
+		t.Error("Expected 'b' to be evicted")
+	}
+	if val, ok := cache.Get("a"); !ok || val != "1" {
+		t.Errorf("Expected 'a'='1', got '%s'", val)
+	}
+	if len(evicted) != 1 || evicted[0] != "b=2" {
+		t.Errorf("Unexpected evictions %v", evicted)
+	}
+
+	hits, misses := cache.Stats()
+	if hits != 2 || misses != 1 {
+		t.Errorf("Expected 2 hits and 1 miss, got %d and %d", hits, misses)
+	}
+}
+
+func TestLRUCacheUpdateAndRemove(t *testing.T) {
+	cache := NewLRUCache(2, 0)
+	cache.Put("a", "1")
+	cache.Put("b", "2")
+	cache.Put("a", "10")
+	cache.Put("c", "3")
+
+	if val, ok := cache.Get("a"); !ok || val != "10" {
+		t.Errorf("Expected 'a'='10', got '%s'", val)
+	}
+	if _, ok := cache.Get("b"); ok {
+		t.Error("Expected 'b' to be evicted after 'a' was updated")
+	}
+
+	if !cache.Remove("a") {
+		t.Error("Expected Remove('a') to succeed")
+	}
+	if cache.Remove("a") {
+		t.Error("Expected second Remove('a') to fail")
+	}
+	if cache.GetSize() != 1 {
+		t.Errorf("Expected size 1, got %d", cache.GetSize())
+	}
+}
+
+func TestLRUCacheTTL(t *testing.T) {
+	now := time.Unix(1000, 0)
+	cache := NewLRUCache(10, time.Minute)
+	cache.now = func() time.Time { return now }
+
+	expired := ""
+	cache.SetEvictCallback(func(key, value string) {
+		expired = key
+	})
+
+	cache.Put("a", "1")
+	now = now.Add(30 * time.Second)
+	if _, ok := cache.Get("a"); !ok {
+		t.Error("Expected 'a' before TTL")
+	}
+
+	now = now.Add(time.Minute)
+	if _, ok := cache.Get("a"); ok {
+		t.Error("Expected 'a' to expire")
+	}
+	if expired != "a" {
+		t.Errorf("Expected callback for 'a', got '%s'", expired)
+	}
+	if cache.GetSize() != 0 {
+		t.Errorf("Expected size 0, got %d", cache.GetSize())
+	}
+}
+
+func TestLRUCacheDropsExpiredBeforeEvictingLive(t *testing.T) {
+	now := time.Unix(1000, 0)
+	cache := NewLRUCache(2, time.Minute)
+	cache.now = func() time.Time { return now }
+	evicted := make([]string, 0)
+	cache.SetEvictCallback(func(key, value string) {
+		evicted = append(evicted, key)
+	})
+
+	cache.Put("old", "1")
+	now = now.Add(30 * time.Second)
+	cache.Put("live", "2")
+	cache.Get("old")
+	now = now.Add(45 * time.Second)
+
+	if cache.GetSize() != 1 {
+		t.Errorf("Expected size 1 with one expired entry, got %d", cache.GetSize())
+	}
+
+	cache.Put("new", "3")
+	if val, ok := cache.Get("live"); !ok || val != "2" {
+		t.Errorf("Expected live entry to survive, got '%s'", val)
+	}
+	if _, ok := cache.Get("new"); !ok {
+		t.Error("Expected new entry to be stored")
+	}
+	if len(evicted) != 1 || evicted[0] != "old" {
+		t.Errorf("Expected only 'old' to be evicted, got %v", evicted)
+	}
+	if cache.GetSize() != 2 {
+		t.Errorf("Expected size 2, got %d", cache.GetSize())
+	}
+}
+
+func TestLRUCacheSaveLoadBinary(t *testing.T) {
+	cache := NewLRUCache(3, 0)
+	cache.Put("a", "1")
+	cache.Put("b", "two words")
+	cache.Put("c", "3")
+	cache.Get("a")
+
+	err := cache.SaveToBinary("lru_cache.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loaded := NewLRUCache(3, 0)
+	err = loaded.LoadFromBinary("lru_cache.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if val, ok := loaded.Get("b"); !ok || val != "two words" {
+		t.Errorf("Expected 'two words', got '%s'", val)
+	}
+
+	// после загрузки наименее недавно использован 'c'
+	loaded.Put("d", "4")
+	if _, ok := loaded.Get("c"); ok {
+		t.Error("Expected 'c' to be evicted after load")
+	}
+
+	os.Remove("lru_cache.bin")
+}
+
+func TestLFUCacheEvictsLeastFrequent(t *testing.T) {
+	cache := NewLFUCache(2, 0)
+	cache.Put("a", "1")
+	cache.Put("b", "2")
+	cache.Get("a")
+	cache.Get("a")
+	cache.Get("b")
+	cache.Put("c", "3")
+
+	if _, ok := cache.Get("b"); ok {
+		t.Error("Expected 'b' to be evicted")
+	}
+	if cache.Frequency("a") != 3 {
+		t.Errorf("Expected frequency 3 for 'a', got %d", cache.Frequency("a"))
+	}
+	if val, ok := cache.Get("c"); !ok || val != "3" {
+		t.Errorf("Expected 'c'='3', got '%s'", val)
+	}
+}
+
+func TestLFUCacheTieBreakAndRemove(t *testing.T) {
+	cache := NewLFUCache(2, 0)
+	cache.Put("a", "1")
+	cache.Put("b", "2")
+	cache.Remove("a")
+	cache.Put("c", "3")
+	cache.Get("b")
+	cache.Get("c")
+	cache.Put("d", "4")
+
+	if _, ok := cache.Get("b"); ok {
+		t.Error("Expected least recently used 'b' to be evicted on tie")
+	}
+	if cache.GetSize() != 2 {
+		t.Errorf("Expected size 2, got %d", cache.GetSize())
+	}
+}
+
+func TestLFUCacheTTLAndSaveLoad(t *testing.T) {
+	now := time.Unix(1000, 0)
+	cache := NewLFUCache(3, time.Minute)
+	cache.now = func() time.Time { return now }
+
+	cache.Put("a", "1")
+	cache.Get("a")
+	now = now.Add(30 * time.Second)
+	cache.Put("b", "2")
+
+	err := cache.SaveToBinary("lfu_cache.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loaded := NewLFUCache(3, time.Minute)
+	loaded.now = func() time.Time { return now.Add(45 * time.Second) }
+	err = loaded.LoadFromBinary("lfu_cache.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if loaded.GetSize() != 1 {
+		t.Errorf("Expected expired 'a' to be dropped on load, size %d", loaded.GetSize())
+	}
+	if loaded.Frequency("b") != 1 {
+		t.Errorf("Expected frequency 1 for 'b', got %d", loaded.Frequency("b"))
+	}
+
+	os.Remove("lfu_cache.bin")
+}
+
+func TestLFUCacheDropsExpiredBeforeEvictingLive(t *testing.T) {
+	now := time.Unix(1000, 0)
+	cache := NewLFUCache(2, time.Minute)
+	cache.now = func() time.Time { return now }
+
+	cache.Put("old", "1")
+	cache.Get("old")
+	cache.Get("old")
+	now = now.Add(30 * time.Second)
+	cache.Put("live", "2")
+	now = now.Add(45 * time.Second)
+
+	cache.Put("new", "3")
+	if _, ok := cache.Get("live"); !ok {
+		t.Error("Expected live entry to survive")
+	}
+	if cache.Frequency("old") != 0 {
+		t.Error("Expected expired entry to be dropped")
+	}
+	if cache.GetSize() != 2 {
+		t.Errorf("Expected size 2, got %d", cache.GetSize())
+	}
+
+	now = now.Add(2 * time.Minute)
+	if n := cache.PurgeExpired(); n != 2 || cache.GetSize() != 0 {
+		t.Errorf("Expected 2 purged entries and empty cache, got %d and %d", n, cache.GetSize())
+	}
+}
+
+func TestCacheLoadErrors(t *testing.T) {
+	lru := NewLRUCache(2, 0)
+	if lru.LoadFromBinary("non_existing.bin") == nil {
+		t.Error("Expected error for non-existing file")
+	}
+
+	list := NewDoublyList()
+	list.PushBack("only key")
+	list.SaveToBinary("lru_cache.bin")
+	if lru.LoadFromBinary("lru_cache.bin") == nil {
+		t.Error("Expected error for corrupted file")
+	}
+
+	lfu := NewLFUCache(2, 0)
+	if lfu.LoadFromBinary("lru_cache.bin") == nil {
+		t.Error("Expected error for corrupted file")
+	}
+
+	os.Remove("lru_cache.bin")
+}
+
+func TestCachesStoreValuesInHashTable(t *testing.T) {
+	now := time.Unix(1000, 0)
+	lru := NewLRUCache(2, time.Minute)
+	lru.now = func() time.Time { return now }
+	lfu := NewLFUCache(2, time.Minute)
+	lfu.now = func() time.Time { return now }
+
+	caches := map[string]struct {
+		core   *cacheCore
+		put    func(key, value string)
+		remove func(key string) bool
+	}{
+		"lru": {&lru.cacheCore, lru.Put, lru.Remove},
+		"lfu": {&lfu.cacheCore, lfu.Put, lfu.Remove},
+	}
+	for name, c := range caches {
+		c.put("a", "1")
+		c.put("b", "2")
+		c.put("a", "updated")
+		if c.core.values.GetSize() != 2 || c.core.values.Get("a") != "updated" {
+			t.Errorf("%s: expected HashTable to hold 2 values with a=updated, got %d and '%s'",
+				name, c.core.values.GetSize(), c.core.values.Get("a"))
+		}
+
+		c.put("c", "3")
+		if c.core.values.GetSize() != 2 || c.core.values.Get("b") != "" || c.core.values.Get("c") != "3" {
+			t.Errorf("%s: expected eviction of b to be reflected in HashTable", name)
+		}
+
+		c.remove("a")
+		if c.core.values.GetSize() != 1 || c.core.values.Get("a") != "" {
+			t.Errorf("%s: expected Remove to drop the value from HashTable", name)
+		}
+	}
+
+	now = now.Add(2 * time.Minute)
+	lru.PurgeExpired()
+	lfu.PurgeExpired()
+	if lru.values.GetSize() != 0 || lfu.values.GetSize() != 0 {
+		t.Error("Expected purged entries to be dropped from HashTable")
+	}
+}
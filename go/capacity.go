@@ -0,0 +1,18 @@
+package main
+
+// minShrinkCapacity — ниже этой ёмкости контейнеры не сжимаются автоматически
+const minShrinkCapacity = 10
+
+// shrinkTarget возвращает уменьшенную вдвое ёмкость, если буфер заполнен
+// не более чем на четверть; запас между порогами 1/4 и 1/2 не даёт
+// чередованию вставок и удалений вызывать постоянные перевыделения
+func shrinkTarget(size, capacity int) (int, bool) {
+	if capacity <= minShrinkCapacity || size > capacity/4 {
+		return capacity, false
+	}
+	newCap := capacity / 2
+	if newCap < minShrinkCapacity {
+		newCap = minShrinkCapacity
+	}
+	return newCap, true
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestShrinkTarget(t *testing.T) {
+	if _, ok := shrinkTarget(0, minShrinkCapacity); ok {
+		t.Error("Expected no shrink at minimal capacity")
+	}
+	if _, ok := shrinkTarget(30, 100); ok {
+		t.Error("Expected no shrink above quarter occupancy")
+	}
+	if newCap, ok := shrinkTarget(25, 100); !ok || newCap != 50 {
+		t.Errorf("Expected shrink to 50, got %d", newCap)
+	}
+	if newCap, ok := shrinkTarget(0, 12); !ok || newCap != minShrinkCapacity {
+		t.Errorf("Expected shrink to %d, got %d", minShrinkCapacity, newCap)
+	}
+}
+
+func TestCapacityStackShrinksAndZeroes(t *testing.T) {
+	s := NewStack(10)
+	for i := 0; i < 1000; i++ {
+		s.Push(strconv.Itoa(i))
+	}
+	grown := s.Cap()
+
+	for i := 0; i < 995; i++ {
+		s.Pop()
+	}
+	if s.Cap() >= grown {
+		t.Errorf("Expected capacity to shrink below %d, got %d", grown, s.Cap())
+	}
+	if s.Peek() != "4" {
+		t.Errorf("Expected '4' on top, got '%s'", s.Peek())
+	}
+
+	s.Pop()
+	if s.data[s.size] != "" {
+		t.Error("Expected popped slot to be zeroed")
+	}
+}
+
+func TestCapacityStackReserveShrinkToFit(t *testing.T) {
+	s := NewStack(1)
+	s.Reserve(64)
+	if s.Cap() != 64 {
+		t.Errorf("Expected capacity 64, got %d", s.Cap())
+	}
+
+	s.Push("a")
+	s.Push("b")
+	s.ShrinkToFit()
+	if s.Cap() != 2 {
+		t.Errorf("Expected capacity 2, got %d", s.Cap())
+	}
+	s.Push("c")
+	if s.Pop() != "c" || s.Pop() != "b" {
+		t.Error("Unexpected order after ShrinkToFit")
+	}
+}
+
+func TestCapacityQueueShrinksAndZeroes(t *testing.T) {
+	q := NewQueue(10)
+	for i := 0; i < 1000; i++ {
+		q.Push(strconv.Itoa(i))
+	}
+	grown := q.Cap()
+
+	for i := 0; i < 995; i++ {
+		q.Pop()
+	}
+	if q.Cap() >= grown {
+		t.Errorf("Expected capacity to shrink below %d, got %d", grown, q.Cap())
+	}
+	if q.Peek() != "995" {
+		t.Errorf("Expected '995' at front, got '%s'", q.Peek())
+	}
+
+	front := q.front
+	q.Pop()
+	if q.data[front] != "" {
+		t.Error("Expected popped slot to be zeroed")
+	}
+}
+
+func TestCapacityQueueReserveShrinkToFit(t *testing.T) {
+	q := NewQueue(3)
+	q.Push("a")
+	q.Push("b")
+	q.Pop()
+	q.Push("c")
+	q.Push("d")
+
+	q.Reserve(32)
+	if q.Cap() != 32 {
+		t.Errorf("Expected capacity 32, got %d", q.Cap())
+	}
+	q.ShrinkToFit()
+	if q.Cap() != 3 {
+		t.Errorf("Expected capacity 3, got %d", q.Cap())
+	}
+	if q.Pop() != "b" || q.Pop() != "c" || q.Pop() != "d" {
+		t.Error("Unexpected order after ShrinkToFit")
+	}
+
+	q.ShrinkToFit()
+	q.Push("e")
+	if q.Peek() != "e" {
+		t.Errorf("Expected 'e', got '%s'", q.Peek())
+	}
+}
+
+func TestCapacityArrayShrinksAndZeroes(t *testing.T) {
+	arr := NewArray(10)
+	for i := 0; i < 1000; i++ {
+		arr.PushBack(strconv.Itoa(i))
+	}
+	grown := arr.Cap()
+
+	arr.RemoveRange(10, 1000)
+	for i := 0; i < 5; i++ {
+		arr.PopBack()
+	}
+	if arr.Cap() >= grown {
+		t.Errorf("Expected capacity to shrink below %d, got %d", grown, arr.Cap())
+	}
+
+	arr.PopFront()
+	arr.RemoveAt(0)
+	if arr.data[arr.GetSize()] != "" || arr.data[arr.GetSize()+1] != "" {
+		t.Error("Expected vacated slots to be zeroed")
+	}
+	val, _ := arr.Get(0)
+	if val != "2" {
+		t.Errorf("Expected '2', got '%s'", val)
+	}
+}
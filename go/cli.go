@@ -0,0 +1,47 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+const cliUsage = `usage:
+  laba3 eval <expression>   evaluate an infix expression
+  laba3 rpn <expression>    print the expression in reverse Polish notation
+`
+
+func runCLI(args []string, stdout, stderr io.Writer) int {
+	if len(args) < 2 {
+		fmt.Fprint(stderr, cliUsage)
+		return 2
+	}
+
+	expr := strings.Join(args[1:], " ")
+	switch args[0] {
+	case "eval":
+		result, err := Evaluate(expr)
+		if err != nil {
+			fmt.Fprintf(stderr, "error: %v\n", err)
+			return 1
+		}
+		fmt.Fprintln(stdout, result)
+	case "rpn":
+		rpn, err := ToRPN(expr)
+		if err != nil {
+			fmt.Fprintf(stderr, "error: %v\n", err)
+			return 1
+		}
+		fmt.Fprintln(stdout, strings.Join(rpn, " "))
+	default:
+		fmt.Fprintf(stderr, "unknown command '%s'\n", args[0])
+		fmt.Fprint(stderr, cliUsage)
+		return 2
+	}
+	return 0
+}
+
+func main() {
+	os.Exit(runCLI(os.Args[1:], os.Stdout, os.Stderr))
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestCLIEval(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	code := runCLI([]string{"eval", "(1 + 2)", "*", "3"}, &stdout, &stderr)
+
+	if code != 0 {
+		t.Errorf("Expected exit code 0, got %d (%s)", code, stderr.String())
+	}
+	if stdout.String() != "9\n" {
+		t.Errorf("Expected '9', got '%s'", stdout.String())
+	}
+}
+
+func TestCLIRPN(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	code := runCLI([]string{"rpn", "1 + 2 * 3"}, &stdout, &stderr)
+
+	if code != 0 {
+		t.Errorf("Expected exit code 0, got %d", code)
+	}
+	if stdout.String() != "1 2 3 * +\n" {
+		t.Errorf("Expected '1 2 3 * +', got '%s'", stdout.String())
+	}
+}
+
+func TestCLIErrors(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if code := runCLI([]string{"eval", "(1"}, &stdout, &stderr); code != 1 {
+		t.Errorf("Expected exit code 1, got %d", code)
+	}
+	if !strings.Contains(stderr.String(), "unmatched '('") {
+		t.Errorf("Unexpected error output '%s'", stderr.String())
+	}
+
+	stderr.Reset()
+	if code := runCLI([]string{"rpn", "1 +"}, &stdout, &stderr); code != 1 {
+		t.Errorf("Expected exit code 1, got %d", code)
+	}
+
+	stderr.Reset()
+	if code := runCLI([]string{"unknown", "1"}, &stdout, &stderr); code != 2 {
+		t.Errorf("Expected exit code 2, got %d", code)
+	}
+	if code := runCLI(nil, &stdout, &stderr); code != 2 {
+		t.Errorf("Expected exit code 2, got %d", code)
+	}
+}
+
+func TestCLIEvalOverflow(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if code := runCLI([]string{"eval", "9223372036854775807 * 2"}, &stdout, &stderr); code != 1 {
+		t.Errorf("Expected exit code 1, got %d (%s)", code, stdout.String())
+	}
+	if !strings.Contains(stderr.String(), "position 21: integer overflow in '*'") {
+		t.Errorf("Unexpected error output '%s'", stderr.String())
+	}
+}
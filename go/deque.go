@@ -0,0 +1,63 @@
+package main
+
+import "fmt"
+
+// Deque — двусторонняя очередь на кольцевом буфере Queue.
+// Push/Pop/Peek и сохранение в файлы унаследованы от Queue, формат файлов совпадает.
+type Deque struct {
+	Queue
+}
+
+func NewDeque(cap int) *Deque {
+	return &Deque{Queue: *NewQueue(cap)}
+}
+
+func (d *Deque) PushFront(val string) {
+	if d.size == d.capacity {
+		d.resize()
+	}
+	d.front = (d.front - 1 + d.capacity) % d.capacity
+	d.data[d.front] = val
+	d.size++
+	if d.size == 1 {
+		d.rear = d.front
+	}
+}
+
+func (d *Deque) PushBack(val string) {
+	d.Push(val)
+}
+
+func (d *Deque) PopFront() string {
+	return d.Pop()
+}
+
+func (d *Deque) PopBack() string {
+	if d.size == 0 {
+		return ""
+	}
+	val := d.data[d.rear]
+	d.data[d.rear] = ""
+	d.rear = (d.rear - 1 + d.capacity) % d.capacity
+	d.size--
+	d.shrink()
+	return val
+}
+
+func (d *Deque) Front() string {
+	return d.Peek()
+}
+
+func (d *Deque) Back() string {
+	if d.size == 0 {
+		return ""
+	}
+	return d.data[d.rear]
+}
+
+func (d *Deque) At(index int) (string, error) {
+	if index < 0 || index >= d.size {
+		return "", fmt.Errorf("index out of range")
+	}
+	return d.data[(d.front+index)%d.capacity], nil
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"os"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestDequePushPopBothEnds(t *testing.T) {
+	d := NewDeque(2)
+	d.PushBack("b")
+	d.PushFront("a")
+	d.PushBack("c")
+	d.PushFront("z")
+
+	if d.GetSize() != 4 {
+		t.Errorf("Expected size 4, got %d", d.GetSize())
+	}
+	if d.Front() != "z" || d.Back() != "c" {
+		t.Errorf("Expected front 'z' and back 'c', got '%s' and '%s'", d.Front(), d.Back())
+	}
+
+	if d.PopBack() != "c" {
+		t.Error("Expected PopBack 'c'")
+	}
+	if d.PopFront() != "z" {
+		t.Error("Expected PopFront 'z'")
+	}
+	if got := slices.Collect(d.All()); !equalSlices(got, []string{"a", "b"}) {
+		t.Errorf("Unexpected deque %v", got)
+	}
+}
+
+func TestDequeEmpty(t *testing.T) {
+	d := NewDeque(10)
+	if d.PopFront() != "" || d.PopBack() != "" {
+		t.Error("Expected empty strings from empty deque")
+	}
+	if d.Front() != "" || d.Back() != "" {
+		t.Error("Expected empty strings for Front/Back of empty deque")
+	}
+
+	d.PushFront("only")
+	if d.Back() != "only" {
+		t.Errorf("Expected back 'only', got '%s'", d.Back())
+	}
+	if d.PopBack() != "only" || d.GetSize() != 0 {
+		t.Error("Expected deque to be empty after PopBack")
+	}
+}
+
+func TestDequeAtWrapAround(t *testing.T) {
+	d := NewDeque(4)
+	d.PushBack("1")
+	d.PushBack("2")
+	d.PushFront("0")
+	d.PushFront("-1")
+
+	for i, want := range []string{"-1", "0", "1", "2"} {
+		val, err := d.At(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if val != want {
+			t.Errorf("Expected '%s' at %d, got '%s'", want, i, val)
+		}
+	}
+	if _, err := d.At(4); err == nil {
+		t.Error("Expected error for At(4)")
+	}
+}
+
+func TestDequeShrinks(t *testing.T) {
+	d := NewDeque(10)
+	for i := 0; i < 1000; i++ {
+		d.PushBack(strconv.Itoa(i))
+	}
+	grown := d.capacity
+
+	for i := 0; i < 990; i++ {
+		d.PopFront()
+	}
+	if d.capacity >= grown {
+		t.Errorf("Expected capacity to shrink below %d, got %d", grown, d.capacity)
+	}
+	if d.capacity < minShrinkCapacity {
+		t.Errorf("Expected capacity at least %d, got %d", minShrinkCapacity, d.capacity)
+	}
+
+	if d.Front() != "990" || d.Back() != "999" {
+		t.Errorf("Unexpected front '%s' and back '%s'", d.Front(), d.Back())
+	}
+}
+
+func TestDequeSaveLoad(t *testing.T) {
+	d := NewDeque(10)
+	d.PushBack("b")
+	d.PushFront("a")
+
+	err := d.SaveToText("deque.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = d.SaveToBinary("deque.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fromText := NewDeque(10)
+	err = fromText.LoadFromText("deque.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fromBinary := NewDeque(10)
+	err = fromBinary.LoadFromBinary("deque.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, loaded := range []*Deque{fromText, fromBinary} {
+		if got := slices.Collect(loaded.All()); !equalSlices(got, []string{"a", "b"}) {
+			t.Errorf("Unexpected loaded deque %v", got)
+		}
+		loaded.PushFront("x")
+		if loaded.Front() != "x" {
+			t.Errorf("Expected front 'x', got '%s'", loaded.Front())
+		}
+	}
+
+	q := NewQueue(10)
+	err = q.LoadFromBinary("deque.bin")
+	if err != nil || q.Peek() != "a" {
+		t.Error("Expected Queue to read the deque binary format")
+	}
+
+	os.Remove("deque.txt")
+	os.Remove("deque.bin")
+}
+
+func TestDequeLoadEmptyThenPush(t *testing.T) {
+	d := NewDeque(10)
+	d.SaveToText("deque.txt")
+
+	loaded := NewDeque(10)
+	err := loaded.LoadFromText("deque.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded.PushFront("a")
+	loaded.PushBack("b")
+	if loaded.GetSize() != 2 {
+		t.Errorf("Expected size 2, got %d", loaded.GetSize())
+	}
+
+	os.Remove("deque.txt")
+}
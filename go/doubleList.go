@@ -7,10 +7,24 @@ import (
 	"os"
 )
 
+// DNode — элемент списка, через который доступны операции за O(1)
 type DNode struct {
 	data string
 	next *DNode
 	prev *DNode
+	list *DoublyList
+}
+
+func (n *DNode) Value() string {
+	return n.data
+}
+
+func (n *DNode) Next() *DNode {
+	return n.next
+}
+
+func (n *DNode) Prev() *DNode {
+	return n.prev
 }
 
 type DoublyList struct {
@@ -24,126 +38,243 @@ func NewDoublyList() *DoublyList {
 }
 
 func (dl *DoublyList) Clear() {
+	for current := dl.head; current != nil; current = current.next {
+		current.list = nil
+	}
 	dl.head = nil
 	dl.tail = nil
 	dl.size = 0
 }
 
-func (dl *DoublyList) PushFront(val string) {
-	newNode := &DNode{data: val}
-	if dl.head == nil {
-		dl.head = newNode
-		dl.tail = newNode
+// linkBefore вставляет node перед mark; при mark == nil — в конец списка
+func (dl *DoublyList) linkBefore(mark, node *DNode) *DNode {
+	node.list = dl
+	node.next = mark
+	if mark != nil {
+		node.prev = mark.prev
+		mark.prev = node
+	} else {
+		node.prev = dl.tail
+		dl.tail = node
+	}
+
+	if node.prev != nil {
+		node.prev.next = node
 	} else {
-		newNode.next = dl.head
-		dl.head.prev = newNode
-		dl.head = newNode
+		dl.head = node
 	}
 	dl.size++
+	return node
 }
 
-func (dl *DoublyList) PushBack(val string) {
-	newNode := &DNode{data: val}
-	if dl.tail == nil {
-		dl.head = newNode
-		dl.tail = newNode
+func (dl *DoublyList) unlink(node *DNode) {
+	if node.prev != nil {
+		node.prev.next = node.next
 	} else {
-		dl.tail.next = newNode
-		newNode.prev = dl.tail
-		dl.tail = newNode
+		dl.head = node.next
 	}
-	dl.size++
+
+	if node.next != nil {
+		node.next.prev = node.prev
+	} else {
+		dl.tail = node.prev
+	}
+	node.next = nil
+	node.prev = nil
+	node.list = nil
+	dl.size--
+}
+
+func (dl *DoublyList) PushFront(val string) *DNode {
+	return dl.linkBefore(dl.head, &DNode{data: val})
+}
+
+func (dl *DoublyList) PushBack(val string) *DNode {
+	return dl.linkBefore(nil, &DNode{data: val})
 }
 
-func (dl *DoublyList) InsertAfter(target, val string) {
+func (dl *DoublyList) InsertAfter(target, val string) error {
 	current := dl.head
 	for current != nil {
 		if current.data == target {
-			newNode := &DNode{data: val}
-			newNode.next = current.next
-			newNode.prev = current
-
-			if current.next != nil {
-				current.next.prev = newNode
-			} else {
-				dl.tail = newNode
-			}
-			current.next = newNode
-			dl.size++
-			return
+			dl.linkBefore(current.next, &DNode{data: val})
+			return nil
 		}
 		current = current.next
 	}
+	return ErrNotFound
 }
 
-func (dl *DoublyList) InsertBefore(target, val string) {
+// InsertAfterAll вставляет val после каждого вхождения target и возвращает число вставок
+func (dl *DoublyList) InsertAfterAll(target, val string) int {
+	count := 0
 	current := dl.head
 	for current != nil {
 		if current.data == target {
-			newNode := &DNode{data: val}
-			newNode.prev = current.prev
-			newNode.next = current
-
-			if current.prev != nil {
-				current.prev.next = newNode
-			} else {
-				dl.head = newNode
-			}
-			current.prev = newNode
-			dl.size++
-			return
+			current = dl.linkBefore(current.next, &DNode{data: val})
+			count++
 		}
 		current = current.next
 	}
+	return count
+}
+
+func (dl *DoublyList) InsertBefore(target, val string) error {
+	current := dl.head
+	for current != nil {
+		if current.data == target {
+			dl.linkBefore(current, &DNode{data: val})
+			return nil
+		}
+		current = current.next
+	}
+	return ErrNotFound
 }
 
 func (dl *DoublyList) PopFront() {
 	if dl.head == nil {
 		return
 	}
-	dl.head = dl.head.next
-	if dl.head != nil {
-		dl.head.prev = nil
-	} else {
-		dl.tail = nil
-	}
-	dl.size--
+	dl.unlink(dl.head)
 }
 
 func (dl *DoublyList) PopBack() {
 	if dl.tail == nil {
 		return
 	}
-	dl.tail = dl.tail.prev
-	if dl.tail != nil {
-		dl.tail.next = nil
-	} else {
-		dl.head = nil
+	dl.unlink(dl.tail)
+}
+
+func (dl *DoublyList) RemoveByValue(val string) error {
+	current := dl.head
+	for current != nil {
+		if current.data == val {
+			dl.unlink(current)
+			return nil
+		}
+		current = current.next
 	}
-	dl.size--
+	return ErrNotFound
 }
 
-func (dl *DoublyList) RemoveByValue(val string) {
+// RemoveAll удаляет все вхождения val и возвращает их количество
+func (dl *DoublyList) RemoveAll(val string) int {
+	removed := 0
 	current := dl.head
 	for current != nil {
+		next := current.next
 		if current.data == val {
-			if current.prev != nil {
-				current.prev.next = current.next
-			} else {
-				dl.head = current.next
-			}
+			dl.unlink(current)
+			removed++
+		}
+		current = next
+	}
+	return removed
+}
 
-			if current.next != nil {
-				current.next.prev = current.prev
-			} else {
-				dl.tail = current.prev
-			}
-			current = nil
-			dl.size--
-			return
+func (dl *DoublyList) IndexOf(val string) int {
+	index := 0
+	for current := dl.head; current != nil; current = current.next {
+		if current.data == val {
+			return index
 		}
-		current = current.next
+		index++
+	}
+	return -1
+}
+
+func (dl *DoublyList) LastIndexOf(val string) int {
+	index := dl.size - 1
+	for current := dl.tail; current != nil; current = current.prev {
+		if current.data == val {
+			return index
+		}
+		index--
+	}
+	return -1
+}
+
+func (dl *DoublyList) CountOf(val string) int {
+	count := 0
+	for current := dl.head; current != nil; current = current.next {
+		if current.data == val {
+			count++
+		}
+	}
+	return count
+}
+
+func (dl *DoublyList) Front() *DNode {
+	return dl.head
+}
+
+func (dl *DoublyList) Back() *DNode {
+	return dl.tail
+}
+
+// At возвращает элемент по индексу, начиная обход с ближайшего конца
+func (dl *DoublyList) At(index int) (*DNode, error) {
+	if index < 0 || index >= dl.size {
+		return nil, fmt.Errorf("index out of range")
+	}
+	if index < dl.size/2 {
+		current := dl.head
+		for i := 0; i < index; i++ {
+			current = current.next
+		}
+		return current, nil
 	}
+	current := dl.tail
+	for i := dl.size - 1; i > index; i-- {
+		current = current.prev
+	}
+	return current, nil
+}
+
+func (dl *DoublyList) InsertAfterElement(mark *DNode, val string) (*DNode, error) {
+	if mark == nil || mark.list != dl {
+		return nil, fmt.Errorf("element does not belong to list")
+	}
+	return dl.linkBefore(mark.next, &DNode{data: val}), nil
+}
+
+func (dl *DoublyList) InsertBeforeElement(mark *DNode, val string) (*DNode, error) {
+	if mark == nil || mark.list != dl {
+		return nil, fmt.Errorf("element does not belong to list")
+	}
+	return dl.linkBefore(mark, &DNode{data: val}), nil
+}
+
+func (dl *DoublyList) Remove(node *DNode) error {
+	if node == nil || node.list != dl {
+		return fmt.Errorf("element does not belong to list")
+	}
+	dl.unlink(node)
+	return nil
+}
+
+func (dl *DoublyList) MoveToFront(node *DNode) error {
+	if node == nil || node.list != dl {
+		return fmt.Errorf("element does not belong to list")
+	}
+	if dl.head == node {
+		return nil
+	}
+	dl.unlink(node)
+	dl.linkBefore(dl.head, node)
+	return nil
+}
+
+func (dl *DoublyList) MoveToBack(node *DNode) error {
+	if node == nil || node.list != dl {
+		return fmt.Errorf("element does not belong to list")
+	}
+	if dl.tail == node {
+		return nil
+	}
+	dl.unlink(node)
+	dl.linkBefore(nil, node)
+	return nil
 }
 
 func (dl *DoublyList) Search(val string) bool {
@@ -2,6 +2,8 @@ package main
 
 import (
 	"os"
+	"slices"
+	"strconv"
 	"testing"
 )
 
@@ -216,3 +218,170 @@ func TestDoubleListPrintMethods(t *testing.T) {
 	list.PrintForward()
 	list.PrintBackward()
 }
+
+func TestDoubleListElementNavigation(t *testing.T) {
+	list := NewDoublyList()
+	b := list.PushBack("b")
+	a := list.PushFront("a")
+	c := list.PushBack("c")
+
+	if list.Front() != a || list.Back() != c {
+		t.Error("Expected Front 'a' and Back 'c'")
+	}
+	if a.Next() != b || b.Next() != c || c.Next() != nil {
+		t.Error("Unexpected Next links")
+	}
+	if c.Prev() != b || b.Prev() != a || a.Prev() != nil {
+		t.Error("Unexpected Prev links")
+	}
+	if b.Value() != "b" {
+		t.Errorf("Expected 'b', got '%s'", b.Value())
+	}
+}
+
+func TestDoubleListInsertAroundElementWithDuplicates(t *testing.T) {
+	list := NewDoublyList()
+	list.PushBack("x")
+	second := list.PushBack("x")
+
+	_, err := list.InsertAfterElement(second, "after")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = list.InsertBeforeElement(second, "before")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := slices.Collect(list.All())
+	if !equalSlices(got, []string{"x", "before", "x", "after"}) {
+		t.Errorf("Unexpected order %v", got)
+	}
+	if list.GetTail() != "after" {
+		t.Errorf("Expected tail 'after', got '%s'", list.GetTail())
+	}
+}
+
+func TestDoubleListMoveAndRemoveElement(t *testing.T) {
+	list := NewDoublyList()
+	a := list.PushBack("a")
+	b := list.PushBack("b")
+	c := list.PushBack("c")
+
+	list.MoveToFront(c)
+	list.MoveToBack(a)
+	got := slices.Collect(list.All())
+	if !equalSlices(got, []string{"c", "b", "a"}) {
+		t.Errorf("Unexpected order after moves %v", got)
+	}
+
+	if err := list.Remove(b); err != nil {
+		t.Fatal(err)
+	}
+	if list.GetSize() != 2 {
+		t.Errorf("Expected size 2, got %d", list.GetSize())
+	}
+	if list.Remove(b) == nil {
+		t.Error("Expected error when removing an already removed element")
+	}
+
+	other := NewDoublyList()
+	foreign := other.PushBack("z")
+	if list.MoveToFront(foreign) == nil {
+		t.Error("Expected error for element of another list")
+	}
+	if _, err := list.InsertAfterElement(foreign, "q"); err == nil {
+		t.Error("Expected error for element of another list")
+	}
+}
+
+func TestDoubleListAt(t *testing.T) {
+	list := NewDoublyList()
+	for _, v := range []string{"0", "1", "2", "3", "4"} {
+		list.PushBack(v)
+	}
+
+	for i := 0; i < 5; i++ {
+		node, err := list.At(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if node.Value() != strconv.Itoa(i) {
+			t.Errorf("Expected '%d', got '%s'", i, node.Value())
+		}
+	}
+
+	if _, err := list.At(5); err == nil {
+		t.Error("Expected error for At(5)")
+	}
+	if _, err := list.At(-1); err == nil {
+		t.Error("Expected error for At(-1)")
+	}
+}
+
+func TestDoubleListClearDetachesElements(t *testing.T) {
+	list := NewDoublyList()
+	node := list.PushBack("a")
+	list.Clear()
+
+	if list.Remove(node) == nil {
+		t.Error("Expected error for element removed by Clear")
+	}
+	if list.GetSize() != 0 {
+		t.Errorf("Expected size 0, got %d", list.GetSize())
+	}
+}
+
+func TestDoubleListNotFoundErrors(t *testing.T) {
+	list := NewDoublyList()
+	list.PushBack("a")
+
+	if list.InsertAfter("missing", "y") != ErrNotFound {
+		t.Error("Expected ErrNotFound for missing target")
+	}
+	if list.InsertBefore("missing", "y") != ErrNotFound {
+		t.Error("Expected ErrNotFound for missing target")
+	}
+	if list.RemoveByValue("missing") != ErrNotFound {
+		t.Error("Expected ErrNotFound for missing value")
+	}
+	if list.RemoveByValue("a") != nil {
+		t.Error("Expected RemoveByValue to succeed")
+	}
+}
+
+func TestDoubleListDuplicates(t *testing.T) {
+	list := NewDoublyList()
+	for _, v := range []string{"x", "a", "x", "b", "x"} {
+		list.PushBack(v)
+	}
+
+	if list.CountOf("x") != 3 {
+		t.Errorf("Expected 3 occurrences, got %d", list.CountOf("x"))
+	}
+	if list.IndexOf("x") != 0 || list.LastIndexOf("x") != 4 {
+		t.Errorf("Expected indexes 0 and 4, got %d and %d", list.IndexOf("x"), list.LastIndexOf("x"))
+	}
+	if list.IndexOf("z") != -1 || list.LastIndexOf("z") != -1 {
+		t.Error("Expected -1 for missing value")
+	}
+
+	if list.InsertAfterAll("x", "y") != 3 {
+		t.Error("Expected 3 insertions")
+	}
+	if list.GetTail() != "y" {
+		t.Errorf("Expected tail 'y', got '%s'", list.GetTail())
+	}
+
+	if list.RemoveAll("x") != 3 {
+		t.Error("Expected 3 removals")
+	}
+	got := slices.Collect(list.All())
+	if !equalSlices(got, []string{"y", "a", "y", "b", "y"}) {
+		t.Errorf("Unexpected list %v", got)
+	}
+	got = slices.Collect(list.Backward())
+	if !equalSlices(got, []string{"y", "b", "y", "a", "y"}) {
+		t.Errorf("Unexpected backward list %v", got)
+	}
+}
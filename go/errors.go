@@ -0,0 +1,12 @@
+package main
+
+import "errors"
+
+// ErrNotFound возвращается операциями над значением, которого нет в контейнере
+var ErrNotFound = errors.New("value not found")
+
+// ErrNotFullTree возвращается, когда операция нарушила бы инвариант полного дерева
+var ErrNotFullTree = errors.New("tree is not full")
+
+// ErrGraphCycle возвращается, когда операция требует графа без циклов
+var ErrGraphCycle = errors.New("graph has a cycle")
@@ -0,0 +1,429 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Number — результат вычисления: целое или вещественное число
+type Number struct {
+	IsFloat bool
+	Int     int64
+	Float   float64
+}
+
+func intNumber(v int64) Number {
+	return Number{Int: v}
+}
+
+func floatNumber(v float64) Number {
+	return Number{IsFloat: true, Float: v}
+}
+
+func (n Number) toFloat() float64 {
+	if n.IsFloat {
+		return n.Float
+	}
+	return float64(n.Int)
+}
+
+// String форматирует число так, чтобы вещественное значение
+// не превращалось в целое при повторном разборе
+func (n Number) String() string {
+	if !n.IsFloat {
+		return strconv.FormatInt(n.Int, 10)
+	}
+	s := strconv.FormatFloat(n.Float, 'g', -1, 64)
+	if !strings.ContainsAny(s, ".eIN") {
+		s += ".0"
+	}
+	return s
+}
+
+func parseNumber(s string) (Number, bool) {
+	if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return intNumber(v), true
+	}
+	if v, err := strconv.ParseFloat(s, 64); err == nil {
+		return floatNumber(v), true
+	}
+	return Number{}, false
+}
+
+// ExprError описывает ошибку разбора или вычисления с позицией (с нуля) во входе
+type ExprError struct {
+	Pos int
+	Msg string
+}
+
+func (e *ExprError) Error() string {
+	return fmt.Sprintf("position %d: %s", e.Pos+1, e.Msg)
+}
+
+// unaryMinus — обозначение унарного минуса в записи RPN
+const unaryMinus = "neg"
+
+var operatorPrecedence = map[string]int{
+	"+":        1,
+	"-":        1,
+	"*":        2,
+	"/":        2,
+	"%":        2,
+	unaryMinus: 3,
+	"^":        4,
+}
+
+var rightAssociative = map[string]bool{
+	unaryMinus: true,
+	"^":        true,
+}
+
+type exprTokenKind int
+
+const (
+	tokenNumber exprTokenKind = iota
+	tokenOperator
+	tokenLeftParen
+	tokenRightParen
+)
+
+type exprToken struct {
+	kind exprTokenKind
+	text string
+	pos  int
+}
+
+// integerLiteralOverflows сообщает, что запись без точки — целое, не влезающее
+// в int64; такое число не должно молча становиться вещественным
+func integerLiteralOverflows(text string) bool {
+	digits := strings.TrimPrefix(text, "-")
+	if digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return false
+	}
+	_, err := strconv.ParseInt(text, 10, 64)
+	return errors.Is(err, strconv.ErrRange)
+}
+
+func tokenizeExpression(expr string) ([]exprToken, error) {
+	tokens := make([]exprToken, 0)
+	i := 0
+	for i < len(expr) {
+		c := expr[i]
+		switch {
+		case c == ' ' || c == '\t':
+			i++
+		case (c >= '0' && c <= '9') || c == '.':
+			start := i
+			for i < len(expr) && ((expr[i] >= '0' && expr[i] <= '9') || expr[i] == '.') {
+				i++
+			}
+			text := expr[start:i]
+			if integerLiteralOverflows(text) {
+				return nil, &ExprError{Pos: start, Msg: fmt.Sprintf("integer literal '%s' out of range", text)}
+			}
+			if _, ok := parseNumber(text); !ok {
+				return nil, &ExprError{Pos: start, Msg: fmt.Sprintf("invalid number '%s'", text)}
+			}
+			tokens = append(tokens, exprToken{kind: tokenNumber, text: text, pos: start})
+		case c == '(':
+			tokens = append(tokens, exprToken{kind: tokenLeftParen, text: "(", pos: i})
+			i++
+		case c == ')':
+			tokens = append(tokens, exprToken{kind: tokenRightParen, text: ")", pos: i})
+			i++
+		case strings.IndexByte("+-*/%^", c) >= 0:
+			tokens = append(tokens, exprToken{kind: tokenOperator, text: string(c), pos: i})
+			i++
+		default:
+			return nil, &ExprError{Pos: i, Msg: fmt.Sprintf("unexpected character '%c'", c)}
+		}
+	}
+	return tokens, nil
+}
+
+// shuntingYard переводит инфиксную запись в RPN.
+// Stack хранит только строки, поэтому в стеке операторов лежат индексы токенов.
+func shuntingYard(tokens []exprToken, end int) ([]exprToken, error) {
+	output := make([]exprToken, 0, len(tokens))
+	ops := NewStack(len(tokens))
+	top := func() exprToken {
+		index, _ := strconv.Atoi(ops.Peek())
+		return tokens[index]
+	}
+	expectOperand := true
+
+	for i, tok := range tokens {
+		switch tok.kind {
+		case tokenNumber:
+			if !expectOperand {
+				return nil, &ExprError{Pos: tok.pos, Msg: fmt.Sprintf("unexpected number '%s'", tok.text)}
+			}
+			output = append(output, tok)
+			expectOperand = false
+		case tokenOperator:
+			if expectOperand {
+				switch tok.text {
+				case "-":
+					tokens[i].text = unaryMinus
+					ops.Push(strconv.Itoa(i))
+				case "+":
+				default:
+					return nil, &ExprError{Pos: tok.pos, Msg: fmt.Sprintf("missing operand before '%s'", tok.text)}
+				}
+				continue
+			}
+			for ops.GetSize() > 0 && top().kind == tokenOperator {
+				prev := top()
+				if operatorPrecedence[prev.text] > operatorPrecedence[tok.text] ||
+					(operatorPrecedence[prev.text] == operatorPrecedence[tok.text] && !rightAssociative[tok.text]) {
+					output = append(output, prev)
+					ops.Pop()
+				} else {
+					break
+				}
+			}
+			ops.Push(strconv.Itoa(i))
+			expectOperand = true
+		case tokenLeftParen:
+			if !expectOperand {
+				return nil, &ExprError{Pos: tok.pos, Msg: "unexpected '('"}
+			}
+			ops.Push(strconv.Itoa(i))
+		case tokenRightParen:
+			if expectOperand {
+				return nil, &ExprError{Pos: tok.pos, Msg: "unexpected ')'"}
+			}
+			for ops.GetSize() > 0 && top().kind != tokenLeftParen {
+				output = append(output, top())
+				ops.Pop()
+			}
+			if ops.GetSize() == 0 {
+				return nil, &ExprError{Pos: tok.pos, Msg: "unmatched ')'"}
+			}
+			ops.Pop()
+		}
+	}
+
+	if expectOperand {
+		return nil, &ExprError{Pos: end, Msg: "unexpected end of expression"}
+	}
+	for ops.GetSize() > 0 {
+		tok := top()
+		if tok.kind == tokenLeftParen {
+			return nil, &ExprError{Pos: tok.pos, Msg: "unmatched '('"}
+		}
+		output = append(output, tok)
+		ops.Pop()
+	}
+	return output, nil
+}
+
+// Целые операции ниже проверяют переполнение int64: калькулятор
+// должен сообщить об ошибке, а не вернуть значение после переноса
+
+func addInt64(a, b int64) (int64, bool) {
+	sum := a + b
+	return sum, (a >= 0) == (b >= 0) && (sum >= 0) != (a >= 0)
+}
+
+func subInt64(a, b int64) (int64, bool) {
+	diff := a - b
+	return diff, (a >= 0) != (b >= 0) && (diff >= 0) != (a >= 0)
+}
+
+func mulInt64(a, b int64) (int64, bool) {
+	if a == 0 || b == 0 {
+		return 0, false
+	}
+	product := a * b
+	overflow := product/b != a ||
+		(a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64)
+	return product, overflow
+}
+
+func powInt64(base, exp int64) (int64, bool) {
+	result := int64(1)
+	for exp > 0 {
+		var overflow bool
+		if exp&1 == 1 {
+			if result, overflow = mulInt64(result, base); overflow {
+				return 0, true
+			}
+		}
+		exp >>= 1
+		if exp > 0 {
+			if base, overflow = mulInt64(base, base); overflow {
+				return 0, true
+			}
+		}
+	}
+	return result, false
+}
+
+func overflowError(op string) error {
+	return fmt.Errorf("integer overflow in '%s'", op)
+}
+
+func applyOperator(op string, a, b Number) (Number, error) {
+	bothInt := !a.IsFloat && !b.IsFloat
+	switch op {
+	case "+":
+		if bothInt {
+			result, overflow := addInt64(a.Int, b.Int)
+			if overflow {
+				return Number{}, overflowError(op)
+			}
+			return intNumber(result), nil
+		}
+		return floatNumber(a.toFloat() + b.toFloat()), nil
+	case "-":
+		if bothInt {
+			result, overflow := subInt64(a.Int, b.Int)
+			if overflow {
+				return Number{}, overflowError(op)
+			}
+			return intNumber(result), nil
+		}
+		return floatNumber(a.toFloat() - b.toFloat()), nil
+	case "*":
+		if bothInt {
+			result, overflow := mulInt64(a.Int, b.Int)
+			if overflow {
+				return Number{}, overflowError(op)
+			}
+			return intNumber(result), nil
+		}
+		return floatNumber(a.toFloat() * b.toFloat()), nil
+	case "/":
+		if b.toFloat() == 0 {
+			return Number{}, fmt.Errorf("division by zero")
+		}
+		if bothInt && a.Int == math.MinInt64 && b.Int == -1 {
+			return Number{}, overflowError(op)
+		}
+		if bothInt && a.Int%b.Int == 0 {
+			return intNumber(a.Int / b.Int), nil
+		}
+		return floatNumber(a.toFloat() / b.toFloat()), nil
+	case "%":
+		if b.toFloat() == 0 {
+			return Number{}, fmt.Errorf("division by zero")
+		}
+		if bothInt {
+			return intNumber(a.Int % b.Int), nil
+		}
+		return floatNumber(math.Mod(a.toFloat(), b.toFloat())), nil
+	case "^":
+		if bothInt && b.Int >= 0 {
+			result, overflow := powInt64(a.Int, b.Int)
+			if overflow {
+				return Number{}, overflowError(op)
+			}
+			return intNumber(result), nil
+		}
+		if a.toFloat() == 0 && b.toFloat() < 0 {
+			return Number{}, fmt.Errorf("division by zero")
+		}
+		result := math.Pow(a.toFloat(), b.toFloat())
+		if math.IsNaN(result) {
+			return Number{}, fmt.Errorf("result is not a real number")
+		}
+		return floatNumber(result), nil
+	}
+	return Number{}, fmt.Errorf("unknown operator '%s'", op)
+}
+
+func evaluateRPN(rpn []exprToken) (Number, error) {
+	operands := NewStack(len(rpn))
+	for _, tok := range rpn {
+		if tok.kind == tokenNumber {
+			operands.Push(tok.text)
+			continue
+		}
+
+		if tok.text == unaryMinus {
+			if operands.GetSize() < 1 {
+				return Number{}, &ExprError{Pos: tok.pos, Msg: "missing operand for '-'"}
+			}
+			value, _ := parseNumber(operands.Pop())
+			if value.IsFloat {
+				value.Float = -value.Float
+			} else if value.Int == math.MinInt64 {
+				return Number{}, &ExprError{Pos: tok.pos, Msg: overflowError("-").Error()}
+			} else {
+				value.Int = -value.Int
+			}
+			operands.Push(value.String())
+			continue
+		}
+
+		if operands.GetSize() < 2 {
+			return Number{}, &ExprError{Pos: tok.pos, Msg: fmt.Sprintf("missing operand for '%s'", tok.text)}
+		}
+		b, _ := parseNumber(operands.Pop())
+		a, _ := parseNumber(operands.Pop())
+		result, err := applyOperator(tok.text, a, b)
+		if err != nil {
+			return Number{}, &ExprError{Pos: tok.pos, Msg: err.Error()}
+		}
+		operands.Push(result.String())
+	}
+
+	if operands.GetSize() != 1 {
+		return Number{}, &ExprError{Pos: 0, Msg: "malformed expression"}
+	}
+	result, _ := parseNumber(operands.Pop())
+	return result, nil
+}
+
+// ToRPN переводит инфиксное выражение в обратную польскую запись;
+// унарный минус обозначается токеном "neg"
+func ToRPN(expr string) ([]string, error) {
+	tokens, err := tokenizeExpression(expr)
+	if err != nil {
+		return nil, err
+	}
+	rpn, err := shuntingYard(tokens, len(expr))
+	if err != nil {
+		return nil, err
+	}
+	result := make([]string, len(rpn))
+	for i, tok := range rpn {
+		result[i] = tok.text
+	}
+	return result, nil
+}
+
+// EvalRPN вычисляет выражение в RPN; позиция в ошибке — номер токена
+func EvalRPN(tokens []string) (Number, error) {
+	rpn := make([]exprToken, len(tokens))
+	for i, text := range tokens {
+		if integerLiteralOverflows(text) {
+			return Number{}, &ExprError{Pos: i, Msg: fmt.Sprintf("integer literal '%s' out of range", text)}
+		}
+		if _, ok := parseNumber(text); ok {
+			rpn[i] = exprToken{kind: tokenNumber, text: text, pos: i}
+		} else if _, ok := operatorPrecedence[text]; ok {
+			rpn[i] = exprToken{kind: tokenOperator, text: text, pos: i}
+		} else {
+			return Number{}, &ExprError{Pos: i, Msg: fmt.Sprintf("unknown token '%s'", text)}
+		}
+	}
+	return evaluateRPN(rpn)
+}
+
+// Evaluate вычисляет инфиксное выражение; позиция в ошибке — номер символа
+func Evaluate(expr string) (Number, error) {
+	tokens, err := tokenizeExpression(expr)
+	if err != nil {
+		return Number{}, err
+	}
+	rpn, err := shuntingYard(tokens, len(expr))
+	if err != nil {
+		return Number{}, err
+	}
+	return evaluateRPN(rpn)
+}
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestExpressionToRPN(t *testing.T) {
+	cases := map[string]string{
+		"1 + 2 * 3":     "1 2 3 * +",
+		"(1 + 2) * 3":   "1 2 + 3 *",
+		"2 ^ 3 ^ 2":     "2 3 2 ^ ^",
+		"10 - 4 - 3":    "10 4 - 3 -",
+		"-2 ^ 2":        "2 2 ^ neg",
+		"-(1 + 2) * +4": "1 2 + neg 4 *",
+	}
+	for expr, want := range cases {
+		rpn, err := ToRPN(expr)
+		if err != nil {
+			t.Errorf("Unexpected error for '%s': %v", expr, err)
+			continue
+		}
+		if got := strings.Join(rpn, " "); got != want {
+			t.Errorf("For '%s' expected '%s', got '%s'", expr, want, got)
+		}
+	}
+}
+
+func TestExpressionEvaluate(t *testing.T) {
+	cases := map[string]string{
+		"2 + 3 * (4 - 1) ^ 2": "29",
+		"7 / 2":               "3.5",
+		"8 / 2":               "4",
+		"1.5 * 2":             "3.0",
+		"7 % 3":               "1",
+		"2 ^ -1":              "0.5",
+		"-2 ^ 2":              "-4",
+	}
+
+	for expr, want := range cases {
+		result, err := Evaluate(expr)
+		if err != nil {
+			t.Errorf("Unexpected error for '%s': %v", expr, err)
+			continue
+		}
+		if result.String() != want {
+			t.Errorf("For '%s' expected '%s', got '%s'", expr, want, result.String())
+		}
+	}
+
+	result, _ := Evaluate("1.5 * 2")
+	if !result.IsFloat || result.Float != 3 {
+		t.Errorf("Expected float 3, got %+v", result)
+	}
+	result, _ = Evaluate("6 * 7")
+	if result.IsFloat || result.Int != 42 {
+		t.Errorf("Expected int 42, got %+v", result)
+	}
+}
+
+func TestExpressionErrors(t *testing.T) {
+	cases := map[string]int{
+		"(1 + 2":                   0,
+		"1 + 2)":                   5,
+		"1 + * 2":                  4,
+		"1 2":                      2,
+		"1 +":                      3,
+		"":                         0,
+		"2 $ 3":                    2,
+		"1 / (2-2)":                2,
+		"1..2 + 3":                 0,
+		"()":                       1,
+		"2 (3)":                    2,
+		"9223372036854775808 - 1":  0,
+		"1 + 99999999999999999999": 4,
+		"0 ^ -1":                   2,
+		"0.0 ^ -2":                 4,
+		"(-8) ^ (1 / 3)":           5,
+	}
+	for expr, pos := range cases {
+		_, err := Evaluate(expr)
+		var exprErr *ExprError
+		if !errors.As(err, &exprErr) {
+			t.Errorf("Expected ExprError for '%s', got %v", expr, err)
+			continue
+		}
+		if exprErr.Pos != pos {
+			t.Errorf("For '%s' expected position %d, got %d (%v)", expr, pos, exprErr.Pos, err)
+		}
+	}
+
+	_, err := Evaluate("1 / 0")
+	if err == nil || !strings.Contains(err.Error(), "position 3: division by zero") {
+		t.Errorf("Unexpected error message %v", err)
+	}
+}
+
+func TestExpressionIntegerOverflow(t *testing.T) {
+	overflows := map[string]int{
+		"9223372036854775807 * 2":            20,
+		"2 ^ 63":                             2,
+		"9223372036854775807 + 1":            20,
+		"0 - 9223372036854775807 - 2":        24,
+		"(0 - 9223372036854775807 - 1) / -1": 30,
+		"-(0 - 9223372036854775807 - 1)":     0,
+		"3037000500 * 3037000500":            11,
+	}
+	for expr, pos := range overflows {
+		_, err := Evaluate(expr)
+		var exprErr *ExprError
+		if !errors.As(err, &exprErr) || !strings.Contains(exprErr.Msg, "integer overflow") {
+			t.Errorf("Expected overflow error for '%s', got %v", expr, err)
+			continue
+		}
+		if exprErr.Pos != pos {
+			t.Errorf("For '%s' expected position %d, got %d", expr, pos, exprErr.Pos)
+		}
+	}
+
+	fits := map[string]string{
+		"2 ^ 62":                      "4611686018427387904",
+		"-2 * 4611686018427387904":    "-9223372036854775808",
+		"0 - 9223372036854775807 - 1": "-9223372036854775808",
+		"3037000499 * 3037000499":     "9223372030926249001",
+		"(-2) ^ 63":                   "-9223372036854775808",
+	}
+	for expr, want := range fits {
+		result, err := Evaluate(expr)
+		if err != nil {
+			t.Errorf("Unexpected error for '%s': %v", expr, err)
+			continue
+		}
+		if result.String() != want {
+			t.Errorf("For '%s' expected '%s', got '%s'", expr, want, result.String())
+		}
+	}
+}
+
+func TestExpressionLiteralRange(t *testing.T) {
+	if _, err := EvalRPN([]string{"9223372036854775808", "1", "-"}); err == nil {
+		t.Error("Expected error for out of range literal in RPN")
+	}
+	for expr, want := range map[string]string{
+		"9223372036854775807":   "9223372036854775807",
+		"9223372036854775808.0": "9.223372036854776e+18",
+		"0 ^ 0":                 "1",
+		"0.0 ^ 2":               "0.0",
+	} {
+		result, err := Evaluate(expr)
+		if err != nil || result.String() != want {
+			t.Errorf("For '%s' expected '%s', got '%s' (%v)", expr, want, result.String(), err)
+		}
+	}
+}
+
+func TestExpressionEvalRPN(t *testing.T) {
+	result, err := EvalRPN([]string{"3", "4", "+", "2", "*"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.String() != "14" {
+		t.Errorf("Expected '14', got '%s'", result.String())
+	}
+
+	result, err = EvalRPN([]string{"5", "neg"})
+	if err != nil || result.String() != "-5" {
+		t.Errorf("Expected '-5', got '%s' (%v)", result.String(), err)
+	}
+
+	if _, err := EvalRPN([]string{"1", "+"}); err == nil {
+		t.Error("Expected error for missing operand")
+	}
+	if _, err := EvalRPN([]string{"1", "2"}); err == nil {
+		t.Error("Expected error for extra operand")
+	}
+	if _, err := EvalRPN([]string{"1", "x"}); err == nil {
+		t.Error("Expected error for unknown token")
+	}
+	if _, err := EvalRPN([]string{"1.5", "0.5", "%"}); err != nil {
+		t.Errorf("Unexpected error for float modulo: %v", err)
+	}
+}
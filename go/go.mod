@@ -1,2 +1,2 @@
 module laba3
-go 1.21
\ No newline at end of file
+go 1.23
\ No newline at end of file
@@ -0,0 +1,475 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Edge — взвешенное ребро графа
+type Edge struct {
+	From   string
+	To     string
+	Weight int
+}
+
+// Graph — граф на списках смежности. Вершины и рёбра перечисляются
+// в порядке добавления, поэтому обходы детерминированы. В неориентированном
+// графе ребро хранится в списках обоих концов.
+type Graph struct {
+	directed bool
+	vertices []string
+	adj      map[string][]Edge
+}
+
+func NewDirectedGraph() *Graph {
+	return &Graph{directed: true, adj: make(map[string][]Edge)}
+}
+
+func NewUndirectedGraph() *Graph {
+	return &Graph{directed: false, adj: make(map[string][]Edge)}
+}
+
+func (g *Graph) IsDirected() bool {
+	return g.directed
+}
+
+// AddVertex добавляет вершину и возвращает false, если она уже была
+func (g *Graph) AddVertex(v string) bool {
+	if _, ok := g.adj[v]; ok {
+		return false
+	}
+	g.vertices = append(g.vertices, v)
+	g.adj[v] = make([]Edge, 0)
+	return true
+}
+
+func (g *Graph) HasVertex(v string) bool {
+	_, ok := g.adj[v]
+	return ok
+}
+
+// setEdge добавляет ребро from -> to или меняет вес существующего
+func (g *Graph) setEdge(from, to string, weight int) {
+	for i, e := range g.adj[from] {
+		if e.To == to {
+			g.adj[from][i].Weight = weight
+			return
+		}
+	}
+	g.adj[from] = append(g.adj[from], Edge{From: from, To: to, Weight: weight})
+}
+
+// AddEdge добавляет ребро, создавая недостающие вершины; повторное
+// добавление ребра между теми же вершинами меняет его вес
+func (g *Graph) AddEdge(from, to string, weight int) {
+	g.AddVertex(from)
+	g.AddVertex(to)
+	g.setEdge(from, to, weight)
+	if !g.directed && from != to {
+		g.setEdge(to, from, weight)
+	}
+}
+
+func (g *Graph) deleteEdge(from, to string) bool {
+	edges := g.adj[from]
+	for i, e := range edges {
+		if e.To == to {
+			g.adj[from] = slices.Delete(edges, i, i+1)
+			return true
+		}
+	}
+	return false
+}
+
+func (g *Graph) RemoveEdge(from, to string) error {
+	if !g.deleteEdge(from, to) {
+		return ErrNotFound
+	}
+	if !g.directed {
+		g.deleteEdge(to, from)
+	}
+	return nil
+}
+
+// RemoveVertex удаляет вершину вместе со всеми входящими и исходящими рёбрами
+func (g *Graph) RemoveVertex(v string) error {
+	if !g.HasVertex(v) {
+		return ErrNotFound
+	}
+	delete(g.adj, v)
+	g.vertices = slices.DeleteFunc(g.vertices, func(u string) bool { return u == v })
+	for _, u := range g.vertices {
+		g.deleteEdge(u, v)
+	}
+	return nil
+}
+
+func (g *Graph) HasEdge(from, to string) bool {
+	_, ok := g.Weight(from, to)
+	return ok
+}
+
+func (g *Graph) Weight(from, to string) (int, bool) {
+	for _, e := range g.adj[from] {
+		if e.To == to {
+			return e.Weight, true
+		}
+	}
+	return 0, false
+}
+
+// Neighbors возвращает концы исходящих рёбер в порядке добавления
+func (g *Graph) Neighbors(v string) []string {
+	result := make([]string, 0, len(g.adj[v]))
+	for _, e := range g.adj[v] {
+		result = append(result, e.To)
+	}
+	return result
+}
+
+func (g *Graph) Vertices() []string {
+	return slices.Clone(g.vertices)
+}
+
+// Edges возвращает все рёбра; в неориентированном графе каждое ребро один раз
+func (g *Graph) Edges() []Edge {
+	result := make([]Edge, 0)
+	position := make(map[string]int, len(g.vertices))
+	for i, v := range g.vertices {
+		position[v] = i
+	}
+	for _, v := range g.vertices {
+		for _, e := range g.adj[v] {
+			if g.directed || position[e.From] <= position[e.To] {
+				result = append(result, e)
+			}
+		}
+	}
+	return result
+}
+
+func (g *Graph) VertexCount() int {
+	return len(g.vertices)
+}
+
+func (g *Graph) EdgeCount() int {
+	return len(g.Edges())
+}
+
+func (g *Graph) Clear() {
+	g.vertices = nil
+	g.adj = make(map[string][]Edge)
+}
+
+func (g *Graph) checkVertex(v string) error {
+	if !g.HasVertex(v) {
+		return fmt.Errorf("unknown vertex %q", v)
+	}
+	return nil
+}
+
+// BFS возвращает вершины, достижимые из start, в порядке обхода в ширину
+func (g *Graph) BFS(start string) ([]string, error) {
+	if err := g.checkVertex(start); err != nil {
+		return nil, err
+	}
+
+	order := make([]string, 0)
+	visited := map[string]bool{start: true}
+	queue := NewQueue(len(g.vertices))
+	queue.Push(start)
+	for queue.GetSize() > 0 {
+		v := queue.Pop()
+		order = append(order, v)
+		for _, e := range g.adj[v] {
+			if !visited[e.To] {
+				visited[e.To] = true
+				queue.Push(e.To)
+			}
+		}
+	}
+	return order, nil
+}
+
+// DFS возвращает вершины, достижимые из start, в том же порядке,
+// что и рекурсивный обход в глубину, но без рекурсии
+func (g *Graph) DFS(start string) ([]string, error) {
+	if err := g.checkVertex(start); err != nil {
+		return nil, err
+	}
+
+	order := make([]string, 0)
+	visited := make(map[string]bool)
+	stack := NewStack(len(g.vertices))
+	stack.Push(start)
+	for stack.GetSize() > 0 {
+		v := stack.Pop()
+		if visited[v] {
+			continue
+		}
+		visited[v] = true
+		order = append(order, v)
+		edges := g.adj[v]
+		for i := len(edges) - 1; i >= 0; i-- {
+			if !visited[edges[i].To] {
+				stack.Push(edges[i].To)
+			}
+		}
+	}
+	return order, nil
+}
+
+// Dijkstra возвращает кратчайшие расстояния от start до всех достижимых вершин
+// и предшественников на кратчайших путях; веса рёбер должны быть неотрицательны
+func (g *Graph) Dijkstra(start string) (map[string]int, map[string]string, error) {
+	if err := g.checkVertex(start); err != nil {
+		return nil, nil, err
+	}
+	for _, e := range g.Edges() {
+		if e.Weight < 0 {
+			return nil, nil, fmt.Errorf("negative edge weight %d from %q to %q", e.Weight, e.From, e.To)
+		}
+	}
+
+	dist := map[string]int{start: 0}
+	prev := make(map[string]string)
+	done := make(map[string]bool)
+	items := make(map[string]*PQItem)
+
+	pq := NewMinPriorityQueue(len(g.vertices))
+	items[start] = pq.Push(start, 0)
+	for pq.GetSize() > 0 {
+		v := pq.Pop()
+		done[v] = true
+		for _, e := range g.adj[v] {
+			if done[e.To] {
+				continue
+			}
+			candidate := dist[v] + e.Weight
+			if best, ok := dist[e.To]; ok && best <= candidate {
+				continue
+			}
+			dist[e.To] = candidate
+			prev[e.To] = v
+			if item, ok := items[e.To]; ok {
+				pq.Update(item, candidate)
+			} else {
+				items[e.To] = pq.Push(e.To, candidate)
+			}
+		}
+	}
+	return dist, prev, nil
+}
+
+// ShortestPath возвращает кратчайший путь от from до to и его длину
+func (g *Graph) ShortestPath(from, to string) ([]string, int, error) {
+	if err := g.checkVertex(to); err != nil {
+		return nil, 0, err
+	}
+	dist, prev, err := g.Dijkstra(from)
+	if err != nil {
+		return nil, 0, err
+	}
+	total, ok := dist[to]
+	if !ok {
+		return nil, 0, fmt.Errorf("no path from %q to %q", from, to)
+	}
+
+	path := []string{to}
+	for v := to; v != from; {
+		v = prev[v]
+		path = append(path, v)
+	}
+	slices.Reverse(path)
+	return path, total, nil
+}
+
+// TopologicalSort упорядочивает вершины ориентированного графа так, что каждое
+// ребро идёт от более ранней вершины к более поздней (алгоритм Кана)
+func (g *Graph) TopologicalSort() ([]string, error) {
+	if !g.directed {
+		return nil, fmt.Errorf("topological sort requires a directed graph")
+	}
+
+	inDegree := make(map[string]int, len(g.vertices))
+	for _, v := range g.vertices {
+		for _, e := range g.adj[v] {
+			inDegree[e.To]++
+		}
+	}
+
+	queue := NewQueue(len(g.vertices))
+	for _, v := range g.vertices {
+		if inDegree[v] == 0 {
+			queue.Push(v)
+		}
+	}
+
+	order := make([]string, 0, len(g.vertices))
+	for queue.GetSize() > 0 {
+		v := queue.Pop()
+		order = append(order, v)
+		for _, e := range g.adj[v] {
+			inDegree[e.To]--
+			if inDegree[e.To] == 0 {
+				queue.Push(e.To)
+			}
+		}
+	}
+	if len(order) != len(g.vertices) {
+		return nil, ErrGraphCycle
+	}
+	return order, nil
+}
+
+// HasCycle ищет ориентированный цикл в ориентированном графе и любой цикл,
+// включая петлю, в неориентированном
+func (g *Graph) HasCycle() bool {
+	if g.directed {
+		_, err := g.TopologicalSort()
+		return err != nil
+	}
+
+	parent := make(map[string]string, len(g.vertices))
+	root := func(v string) string {
+		for parent[v] != v {
+			parent[v] = parent[parent[v]]
+			v = parent[v]
+		}
+		return v
+	}
+	for _, v := range g.vertices {
+		parent[v] = v
+	}
+	for _, e := range g.Edges() {
+		a, b := root(e.From), root(e.To)
+		if a == b {
+			return true
+		}
+		parent[a] = b
+	}
+	return false
+}
+
+// ConnectedComponents возвращает компоненты связности; для ориентированного
+// графа направление рёбер не учитывается (компоненты слабой связности)
+func (g *Graph) ConnectedComponents() [][]string {
+	undirected := g
+	if g.directed {
+		undirected = NewUndirectedGraph()
+		for _, v := range g.vertices {
+			undirected.AddVertex(v)
+		}
+		for _, e := range g.Edges() {
+			undirected.AddEdge(e.From, e.To, e.Weight)
+		}
+	}
+
+	components := make([][]string, 0)
+	seen := make(map[string]bool, len(g.vertices))
+	for _, v := range g.vertices {
+		if seen[v] {
+			continue
+		}
+		component, _ := undirected.BFS(v)
+		for _, u := range component {
+			seen[u] = true
+		}
+		components = append(components, component)
+	}
+	return components
+}
+
+// SaveToText пишет список рёбер: первая строка — "directed N" или
+// "undirected N", где N — число следующих строк; далее по строке на каждую
+// вершину в порядке добавления и строки рёбер "from to weight"
+func (g *Graph) SaveToText(filename string) error {
+	for _, v := range g.vertices {
+		if v == "" || strings.ContainsAny(v, " \t\r\n") {
+			return fmt.Errorf("vertex %q cannot be written to an edge list", v)
+		}
+	}
+
+	// сначала все вершины в порядке добавления, чтобы после загрузки
+	// Vertices и обходы давали тот же порядок, затем рёбра
+	lines := slices.Clone(g.vertices)
+	for _, e := range g.Edges() {
+		lines = append(lines, fmt.Sprintf("%s %s %d", e.From, e.To, e.Weight))
+	}
+
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	kind := "undirected"
+	if g.directed {
+		kind = "directed"
+	}
+	writer := bufio.NewWriter(file)
+	fmt.Fprintf(writer, "%s %d\n", kind, len(lines))
+	for _, line := range lines {
+		fmt.Fprintln(writer, line)
+	}
+	return writer.Flush()
+}
+
+// LoadFromText читает список рёбер в формате SaveToText; вес можно опустить,
+// тогда он равен 1
+func (g *Graph) LoadFromText(filename string) error {
+	file, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return err
+		}
+		return fmt.Errorf("missing edge list header")
+	}
+	header := strings.Fields(scanner.Text())
+	if len(header) != 2 || (header[0] != "directed" && header[0] != "undirected") {
+		return fmt.Errorf("invalid edge list header %q", scanner.Text())
+	}
+	count, err := strconv.Atoi(header[1])
+	if err != nil {
+		return err
+	}
+
+	loaded := NewUndirectedGraph()
+	loaded.directed = header[0] == "directed"
+	for i := 0; i < count; i++ {
+		if !scanner.Scan() {
+			if err := scanner.Err(); err != nil {
+				return err
+			}
+			return fmt.Errorf("expected %d edge list lines, got %d", count, i)
+		}
+		fields := strings.Fields(scanner.Text())
+		switch len(fields) {
+		case 1:
+			loaded.AddVertex(fields[0])
+		case 2:
+			loaded.AddEdge(fields[0], fields[1], 1)
+		case 3:
+			weight, err := strconv.Atoi(fields[2])
+			if err != nil {
+				return err
+			}
+			loaded.AddEdge(fields[0], fields[1], weight)
+		default:
+			return fmt.Errorf("invalid edge list line %q", scanner.Text())
+		}
+	}
+
+	*g = *loaded
+	return nil
+}
@@ -0,0 +1,250 @@
+package main
+
+import (
+	"os"
+	"slices"
+	"testing"
+)
+
+// newSampleGraph:
+//
+//	a --1-- b --2-- d
+//	|       |
+//	4       1
+//	|       |
+//	c -------
+func newSampleGraph(directed bool) *Graph {
+	g := NewUndirectedGraph()
+	if directed {
+		g = NewDirectedGraph()
+	}
+	g.AddEdge("a", "b", 1)
+	g.AddEdge("a", "c", 4)
+	g.AddEdge("b", "c", 1)
+	g.AddEdge("b", "d", 2)
+	return g
+}
+
+func TestGraphAddRemove(t *testing.T) {
+	g := newSampleGraph(false)
+
+	if g.VertexCount() != 4 || g.EdgeCount() != 4 {
+		t.Errorf("Expected 4 vertices and 4 edges, got %d and %d", g.VertexCount(), g.EdgeCount())
+	}
+	if !g.HasEdge("c", "a") {
+		t.Error("Expected undirected edge to be visible from both ends")
+	}
+
+	g.AddEdge("c", "a", 7)
+	if w, _ := g.Weight("a", "c"); w != 7 || g.EdgeCount() != 4 {
+		t.Errorf("Expected re-adding an edge to update weight, got %d with %d edges", w, g.EdgeCount())
+	}
+
+	if err := g.RemoveEdge("a", "d"); err != ErrNotFound {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+	if err := g.RemoveEdge("b", "a"); err != nil || g.HasEdge("a", "b") {
+		t.Errorf("Expected edge a-b to be removed, got %v", err)
+	}
+
+	if err := g.RemoveVertex("c"); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(g.Vertices(), []string{"a", "b", "d"}) || g.EdgeCount() != 1 {
+		t.Errorf("Unexpected graph after RemoveVertex: %v, %v", g.Vertices(), g.Edges())
+	}
+	if !slices.Equal(g.Neighbors("a"), []string{}) {
+		t.Errorf("Expected a to have no neighbors, got %v", g.Neighbors("a"))
+	}
+
+	directed := newSampleGraph(true)
+	if directed.HasEdge("b", "a") || !directed.HasEdge("a", "b") {
+		t.Error("Expected directed edge only from a to b")
+	}
+}
+
+func TestGraphTraversals(t *testing.T) {
+	g := newSampleGraph(false)
+	g.AddVertex("lonely")
+
+	bfs, err := g.BFS("a")
+	if err != nil || !slices.Equal(bfs, []string{"a", "b", "c", "d"}) {
+		t.Errorf("Expected BFS [a b c d], got %v, %v", bfs, err)
+	}
+	dfs, err := g.DFS("a")
+	if err != nil || !slices.Equal(dfs, []string{"a", "b", "c", "d"}) {
+		t.Errorf("Expected DFS [a b c d], got %v, %v", dfs, err)
+	}
+	dfs, _ = g.DFS("d")
+	if !slices.Equal(dfs, []string{"d", "b", "a", "c"}) {
+		t.Errorf("Expected DFS [d b a c], got %v", dfs)
+	}
+
+	if _, err := g.BFS("missing"); err == nil {
+		t.Error("Expected error for unknown start vertex")
+	}
+}
+
+func TestGraphShortestPath(t *testing.T) {
+	g := newSampleGraph(false)
+
+	path, dist, err := g.ShortestPath("a", "c")
+	if err != nil || dist != 2 || !slices.Equal(path, []string{"a", "b", "c"}) {
+		t.Errorf("Expected path [a b c] of length 2, got %v of %d, %v", path, dist, err)
+	}
+
+	path, dist, err = g.ShortestPath("d", "d")
+	if err != nil || dist != 0 || !slices.Equal(path, []string{"d"}) {
+		t.Errorf("Expected trivial path [d], got %v of %d, %v", path, dist, err)
+	}
+
+	directed := newSampleGraph(true)
+	if _, _, err := directed.ShortestPath("d", "a"); err == nil {
+		t.Error("Expected error for unreachable vertex")
+	}
+
+	directed.AddEdge("c", "d", -1)
+	if _, _, err := directed.ShortestPath("a", "d"); err == nil {
+		t.Error("Expected error for negative weight")
+	}
+}
+
+func TestGraphTopologicalSortAndCycles(t *testing.T) {
+	g := NewDirectedGraph()
+	g.AddEdge("shirt", "tie", 1)
+	g.AddEdge("tie", "jacket", 1)
+	g.AddEdge("trousers", "shoes", 1)
+	g.AddEdge("trousers", "jacket", 1)
+	g.AddVertex("watch")
+
+	order, err := g.TopologicalSort()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(order, []string{"shirt", "trousers", "watch", "tie", "shoes", "jacket"}) {
+		t.Errorf("Unexpected topological order %v", order)
+	}
+	if g.HasCycle() {
+		t.Error("Expected no cycle")
+	}
+
+	g.AddEdge("jacket", "shirt", 1)
+	if _, err := g.TopologicalSort(); err != ErrGraphCycle {
+		t.Errorf("Expected ErrGraphCycle, got %v", err)
+	}
+	if !g.HasCycle() {
+		t.Error("Expected a cycle")
+	}
+
+	if _, err := newSampleGraph(false).TopologicalSort(); err == nil {
+		t.Error("Expected error for undirected graph")
+	}
+
+	tree := NewUndirectedGraph()
+	tree.AddEdge("a", "b", 1)
+	tree.AddEdge("a", "c", 1)
+	if tree.HasCycle() {
+		t.Error("Expected undirected tree to have no cycle")
+	}
+	if !newSampleGraph(false).HasCycle() {
+		t.Error("Expected triangle a-b-c to be a cycle")
+	}
+	tree.AddEdge("c", "c", 1)
+	if !tree.HasCycle() {
+		t.Error("Expected self-loop to be a cycle")
+	}
+}
+
+func TestGraphConnectedComponents(t *testing.T) {
+	g := NewDirectedGraph()
+	g.AddEdge("a", "b", 1)
+	g.AddEdge("c", "b", 1)
+	g.AddEdge("x", "y", 1)
+	g.AddVertex("z")
+
+	components := g.ConnectedComponents()
+	expected := [][]string{{"a", "b", "c"}, {"x", "y"}, {"z"}}
+	if len(components) != len(expected) {
+		t.Fatalf("Expected %d components, got %v", len(expected), components)
+	}
+	for i := range expected {
+		if !slices.Equal(components[i], expected[i]) {
+			t.Errorf("Expected component %v, got %v", expected[i], components[i])
+		}
+	}
+}
+
+func TestGraphSaveLoadText(t *testing.T) {
+	g := newSampleGraph(true)
+	g.AddVertex("e")
+
+	if err := g.SaveToText("graph.txt"); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove("graph.txt")
+
+	content, _ := os.ReadFile("graph.txt")
+	expected := "directed 9\na\nb\nc\nd\ne\na b 1\na c 4\nb c 1\nb d 2\n"
+	if string(content) != expected {
+		t.Errorf("Expected file:\n%s\ngot:\n%s", expected, content)
+	}
+
+	loaded := NewUndirectedGraph()
+	if err := loaded.LoadFromText("graph.txt"); err != nil {
+		t.Fatal(err)
+	}
+	if !loaded.IsDirected() || !slices.Equal(loaded.Vertices(), g.Vertices()) || !slices.Equal(loaded.Edges(), g.Edges()) {
+		t.Errorf("Expected %v, got %v", g.Edges(), loaded.Edges())
+	}
+
+	os.WriteFile("graph.txt", []byte("undirected 2\nu v\nv w 3\n"), 0644)
+	if err := loaded.LoadFromText("graph.txt"); err != nil {
+		t.Fatal(err)
+	}
+	if w, _ := loaded.Weight("v", "u"); loaded.IsDirected() || w != 1 {
+		t.Errorf("Expected undirected edge v-u with default weight 1, got %d", w)
+	}
+
+	os.WriteFile("graph.txt", []byte("sideways 0\n"), 0644)
+	if loaded.LoadFromText("graph.txt") == nil {
+		t.Error("Expected error for invalid header")
+	}
+
+	bad := NewDirectedGraph()
+	bad.AddVertex("two words")
+	if bad.SaveToText("graph.txt") == nil {
+		t.Error("Expected error for vertex with whitespace")
+	}
+}
+
+func TestGraphSaveLoadKeepsVertexOrder(t *testing.T) {
+	g := NewUndirectedGraph()
+	g.AddVertex("z")
+	g.AddEdge("a", "b", 1)
+	g.AddVertex("lonely")
+	g.AddEdge("c", "b", 2)
+
+	if err := g.SaveToText("graph.txt"); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove("graph.txt")
+
+	loaded := NewDirectedGraph()
+	if err := loaded.LoadFromText("graph.txt"); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(loaded.Vertices(), []string{"z", "a", "b", "lonely", "c"}) {
+		t.Errorf("Expected vertex order [z a b lonely c], got %v", loaded.Vertices())
+	}
+
+	want := g.ConnectedComponents()
+	got := loaded.ConnectedComponents()
+	if len(got) != len(want) {
+		t.Fatalf("Expected components %v, got %v", want, got)
+	}
+	for i := range want {
+		if !slices.Equal(got[i], want[i]) {
+			t.Errorf("Expected component %v, got %v", want[i], got[i])
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package main
+
+import "iter"
+
+func (a *Array) All() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		a.Each(func(_ int, value string) bool {
+			return yield(value)
+		})
+	}
+}
+
+func (a *Array) Indexed() iter.Seq2[int, string] {
+	return func(yield func(int, string) bool) {
+		a.Each(yield)
+	}
+}
+
+func (sl *SinglyList) All() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		sl.Each(func(_ int, value string) bool {
+			return yield(value)
+		})
+	}
+}
+
+func (dl *DoublyList) All() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		dl.Each(func(_ int, value string) bool {
+			return yield(value)
+		})
+	}
+}
+
+func (dl *DoublyList) Backward() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for current := dl.tail; current != nil; current = current.prev {
+			if !yield(current.data) {
+				return
+			}
+		}
+	}
+}
+
+// All обходит стек от дна к вершине, не извлекая элементы
+func (s *Stack) All() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		s.Each(func(_ int, value string) bool {
+			return yield(value)
+		})
+	}
+}
+
+// Drain извлекает элементы с вершины, пока стек не опустеет.
+// При досрочном выходе из цикла уже выданный элемент считается извлечённым.
+func (s *Stack) Drain() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for s.size > 0 {
+			if !yield(s.Pop()) {
+				return
+			}
+		}
+	}
+}
+
+func (q *Queue) All() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		q.Each(func(_ int, value string) bool {
+			return yield(value)
+		})
+	}
+}
+
+// Drain извлекает элементы из головы, пока очередь не опустеет.
+// При досрочном выходе из цикла уже выданный элемент считается извлечённым.
+func (q *Queue) Drain() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for q.size > 0 {
+			if !yield(q.Pop()) {
+				return
+			}
+		}
+	}
+}
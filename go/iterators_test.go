@@ -0,0 +1,117 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestIteratorsListsAll(t *testing.T) {
+	sl := NewSinglyList()
+	dl := NewDoublyList()
+	for _, v := range []string{"a", "b", "c"} {
+		sl.PushBack(v)
+		dl.PushBack(v)
+	}
+
+	if got := slices.Collect(sl.All()); !equalSlices(got, []string{"a", "b", "c"}) {
+		t.Errorf("Unexpected SinglyList.All result %v", got)
+	}
+	if got := slices.Collect(dl.All()); !equalSlices(got, []string{"a", "b", "c"}) {
+		t.Errorf("Unexpected DoublyList.All result %v", got)
+	}
+	if got := slices.Collect(dl.Backward()); !equalSlices(got, []string{"c", "b", "a"}) {
+		t.Errorf("Unexpected DoublyList.Backward result %v", got)
+	}
+}
+
+func TestIteratorsEarlyBreak(t *testing.T) {
+	sl := NewSinglyList()
+	dl := NewDoublyList()
+	for _, v := range []string{"a", "b", "c"} {
+		sl.PushBack(v)
+		dl.PushBack(v)
+	}
+
+	visited := 0
+	for v := range sl.All() {
+		visited++
+		if v == "b" {
+			break
+		}
+	}
+	if visited != 2 {
+		t.Errorf("Expected 2 visited elements, got %d", visited)
+	}
+
+	visited = 0
+	for range dl.Backward() {
+		visited++
+		break
+	}
+	if visited != 1 {
+		t.Errorf("Expected 1 visited element, got %d", visited)
+	}
+}
+
+func TestIteratorsArrayIndexed(t *testing.T) {
+	arr := NewArray(10)
+	arr.AppendAll("x", "y", "z")
+
+	for i, v := range arr.Indexed() {
+		want, _ := arr.Get(i)
+		if v != want {
+			t.Errorf("Expected '%s' at %d, got '%s'", want, i, v)
+		}
+		if i == 1 {
+			break
+		}
+	}
+
+	if got := slices.Collect(arr.All()); !equalSlices(got, []string{"x", "y", "z"}) {
+		t.Errorf("Unexpected Array.All result %v", got)
+	}
+}
+
+func TestIteratorsStackQueueAll(t *testing.T) {
+	s := NewStack(10)
+	q := NewQueue(10)
+	for _, v := range []string{"1", "2", "3"} {
+		s.Push(v)
+		q.Push(v)
+	}
+
+	if got := slices.Collect(s.All()); !equalSlices(got, []string{"1", "2", "3"}) {
+		t.Errorf("Unexpected Stack.All result %v", got)
+	}
+	if got := slices.Collect(q.All()); !equalSlices(got, []string{"1", "2", "3"}) {
+		t.Errorf("Unexpected Queue.All result %v", got)
+	}
+	if s.GetSize() != 3 || q.GetSize() != 3 {
+		t.Error("All must not remove elements")
+	}
+}
+
+func TestIteratorsDrain(t *testing.T) {
+	s := NewStack(10)
+	q := NewQueue(10)
+	for _, v := range []string{"1", "2", "3"} {
+		s.Push(v)
+		q.Push(v)
+	}
+
+	for v := range s.Drain() {
+		if v == "2" {
+			break
+		}
+	}
+	if s.GetSize() != 1 || s.Peek() != "1" {
+		t.Errorf("Expected only '1' left in stack, got size %d", s.GetSize())
+	}
+
+	if got := slices.Collect(q.Drain()); !equalSlices(got, []string{"1", "2", "3"}) {
+		t.Errorf("Unexpected Queue.Drain result %v", got)
+	}
+	if q.GetSize() != 0 {
+		t.Errorf("Expected empty queue, got size %d", q.GetSize())
+	}
+}
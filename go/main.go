@@ -14,8 +14,14 @@ func TestMain(m *testing.M) {
 		"queue.txt", "queue.bin",
 		"stack_txt.txt", "stack_bin.dat",
 		"hash.txt",
-		"fulltree_test.bin",
+		"fulltree_test.bin", "fulltree_test.txt",
 		"slist.txt", "slist.bin",
+		"lru_cache.bin", "lfu_cache.bin",
+		"deque.txt", "deque.bin",
+		"pq.txt", "pq.bin",
+		"trie.txt", "trie.bin", "radix.bin",
+		"graph.txt", "skiplist.txt", "skiplist.bin",
+		"set.txt", "set.bin", "sorted_set.txt",
 	}
 
 	for _, file := range filesToRemove {
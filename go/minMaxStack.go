@@ -0,0 +1,141 @@
+package main
+
+import "fmt"
+
+// MinMaxStack — стек на основе Stack с Min/Max за O(1) и откатом к снимкам.
+// Для каждого уровня хранятся текущие минимум и максимум в параллельных стеках.
+// По умолчанию строки сравниваются лексикографически ("10" < "9");
+// для чисел и другого порядка используйте NewMinMaxStackFunc.
+type MinMaxStack struct {
+	values *Stack
+	mins   *Stack
+	maxs   *Stack
+	less   func(a, b string) bool
+
+	// undoLog хранит "+" для Push и "-значение" для Pop, пока есть снимки
+	undoLog *Stack
+	marks   []snapshotMark
+	nextID  int
+	epoch   int
+}
+
+type snapshotMark struct {
+	id      int
+	logSize int
+}
+
+// Snapshot — дескриптор состояния стека для Rollback
+type Snapshot struct {
+	stack *MinMaxStack
+	id    int
+	index int
+	epoch int
+}
+
+// NewMinMaxStack создаёт стек с лексикографическим сравнением строк
+func NewMinMaxStack(initialCapacity int) *MinMaxStack {
+	return NewMinMaxStackFunc(initialCapacity, func(a, b string) bool { return a < b })
+}
+
+// NewMinMaxStackFunc создаёт стек, в котором Min и Max определяются функцией less
+func NewMinMaxStackFunc(initialCapacity int, less func(a, b string) bool) *MinMaxStack {
+	return &MinMaxStack{
+		values:  NewStack(initialCapacity),
+		mins:    NewStack(initialCapacity),
+		maxs:    NewStack(initialCapacity),
+		less:    less,
+		undoLog: NewStack(0),
+	}
+}
+
+func (s *MinMaxStack) push(value string) {
+	minValue, maxValue := value, value
+	if s.values.GetSize() > 0 {
+		if top := s.mins.Peek(); !s.less(value, top) {
+			minValue = top
+		}
+		if top := s.maxs.Peek(); !s.less(top, value) {
+			maxValue = top
+		}
+	}
+	s.values.Push(value)
+	s.mins.Push(minValue)
+	s.maxs.Push(maxValue)
+}
+
+func (s *MinMaxStack) pop() string {
+	s.mins.Pop()
+	s.maxs.Pop()
+	return s.values.Pop()
+}
+
+func (s *MinMaxStack) Push(value string) {
+	s.push(value)
+	if len(s.marks) > 0 {
+		s.undoLog.Push("+")
+	}
+}
+
+func (s *MinMaxStack) Pop() string {
+	if s.values.GetSize() == 0 {
+		return ""
+	}
+	value := s.pop()
+	if len(s.marks) > 0 {
+		s.undoLog.Push("-" + value)
+	}
+	return value
+}
+
+func (s *MinMaxStack) Peek() string {
+	return s.values.Peek()
+}
+
+// Min возвращает наименьший элемент в порядке стека или "", если стек пуст
+func (s *MinMaxStack) Min() string {
+	return s.mins.Peek()
+}
+
+// Max возвращает наибольший элемент в порядке стека или "", если стек пуст
+func (s *MinMaxStack) Max() string {
+	return s.maxs.Peek()
+}
+
+func (s *MinMaxStack) GetSize() int {
+	return s.values.GetSize()
+}
+
+// Snapshot запоминает текущее состояние за O(1); откат стоит
+// пропорционально числу операций после снимка
+func (s *MinMaxStack) Snapshot() Snapshot {
+	mark := snapshotMark{id: s.nextID, logSize: s.undoLog.GetSize()}
+	s.nextID++
+	s.marks = append(s.marks, mark)
+	return Snapshot{stack: s, id: mark.id, index: len(s.marks) - 1, epoch: s.epoch}
+}
+
+// Rollback возвращает стек к снимку; снимки, сделанные позже, становятся недействительными
+func (s *MinMaxStack) Rollback(snap Snapshot) error {
+	if snap.stack != s || snap.epoch != s.epoch || snap.index >= len(s.marks) || s.marks[snap.index].id != snap.id {
+		return fmt.Errorf("invalid snapshot")
+	}
+
+	target := s.marks[snap.index].logSize
+	for s.undoLog.GetSize() > target {
+		entry := s.undoLog.Pop()
+		if entry == "+" {
+			s.pop()
+		} else {
+			s.push(entry[1:])
+		}
+	}
+	s.marks = s.marks[:snap.index+1]
+	return nil
+}
+
+// Commit фиксирует изменения: журнал очищается, все снимки становятся недействительными
+func (s *MinMaxStack) Commit() {
+	s.undoLog = NewStack(0)
+	s.marks = nil
+	s.epoch++
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestMinMaxStackMinMax(t *testing.T) {
+	s := NewMinMaxStack(2)
+	if s.Min() != "" || s.Max() != "" {
+		t.Error("Expected empty Min/Max on empty stack")
+	}
+
+	s.Push("m")
+	s.Push("c")
+	s.Push("x")
+	s.Push("c")
+
+	if s.Min() != "c" || s.Max() != "x" {
+		t.Errorf("Expected min 'c' and max 'x', got '%s' and '%s'", s.Min(), s.Max())
+	}
+
+	s.Pop()
+	s.Pop()
+	if s.Min() != "c" || s.Max() != "m" {
+		t.Errorf("Expected min 'c' and max 'm', got '%s' and '%s'", s.Min(), s.Max())
+	}
+
+	s.Pop()
+	if s.Min() != "m" || s.Max() != "m" || s.Peek() != "m" {
+		t.Error("Expected only 'm' to remain")
+	}
+	s.Pop()
+	if s.Pop() != "" || s.GetSize() != 0 {
+		t.Error("Expected empty stack")
+	}
+}
+
+func TestMinMaxStackOrdering(t *testing.T) {
+	lexical := NewMinMaxStack(0)
+	lexical.Push("9")
+	lexical.Push("10")
+	if lexical.Min() != "10" || lexical.Max() != "9" {
+		t.Errorf("Expected lexicographic min '10' and max '9', got '%s' and '%s'", lexical.Min(), lexical.Max())
+	}
+
+	numeric := NewMinMaxStackFunc(0, func(a, b string) bool {
+		x, _ := strconv.Atoi(a)
+		y, _ := strconv.Atoi(b)
+		return x < y
+	})
+	for _, v := range []string{"9", "10", "-3", "100"} {
+		numeric.Push(v)
+	}
+	if numeric.Min() != "-3" || numeric.Max() != "100" {
+		t.Errorf("Expected numeric min '-3' and max '100', got '%s' and '%s'", numeric.Min(), numeric.Max())
+	}
+	numeric.Pop()
+	numeric.Pop()
+	if numeric.Min() != "9" || numeric.Max() != "10" {
+		t.Errorf("Expected numeric min '9' and max '10', got '%s' and '%s'", numeric.Min(), numeric.Max())
+	}
+}
+
+func TestMinMaxStackRollback(t *testing.T) {
+	s := NewMinMaxStack(10)
+	s.Push("b")
+	s.Push("d")
+
+	snap := s.Snapshot()
+	s.Pop()
+	s.Pop()
+	s.Push("a")
+	s.Push("z")
+
+	if s.Min() != "a" || s.Max() != "z" {
+		t.Errorf("Expected min 'a' and max 'z', got '%s' and '%s'", s.Min(), s.Max())
+	}
+
+	if err := s.Rollback(snap); err != nil {
+		t.Fatal(err)
+	}
+	if s.GetSize() != 2 || s.Peek() != "d" {
+		t.Errorf("Expected 'd' on top of 2 elements, got '%s'", s.Peek())
+	}
+	if s.Min() != "b" || s.Max() != "d" {
+		t.Errorf("Expected min 'b' and max 'd', got '%s' and '%s'", s.Min(), s.Max())
+	}
+
+	s.Push("q")
+	if err := s.Rollback(snap); err != nil {
+		t.Fatal(err)
+	}
+	if s.Peek() != "d" {
+		t.Errorf("Expected repeated rollback to restore 'd', got '%s'", s.Peek())
+	}
+}
+
+func TestMinMaxStackNestedSnapshots(t *testing.T) {
+	s := NewMinMaxStack(10)
+	first := s.Snapshot()
+	s.Push("a")
+	second := s.Snapshot()
+	s.Push("b")
+
+	if err := s.Rollback(first); err != nil {
+		t.Fatal(err)
+	}
+	if s.GetSize() != 0 {
+		t.Errorf("Expected empty stack, got size %d", s.GetSize())
+	}
+	if s.Rollback(second) == nil {
+		t.Error("Expected later snapshot to be invalidated")
+	}
+
+	s.Push("c")
+	third := s.Snapshot()
+	if s.Rollback(second) == nil {
+		t.Error("Expected stale snapshot to stay invalid")
+	}
+	if err := s.Rollback(third); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMinMaxStackCommit(t *testing.T) {
+	s := NewMinMaxStack(10)
+	snap := s.Snapshot()
+	s.Push("a")
+	s.Commit()
+
+	if s.Rollback(snap) == nil {
+		t.Error("Expected snapshot to be invalid after Commit")
+	}
+	s.Push("b")
+	if s.undoLog.GetSize() != 0 {
+		t.Errorf("Expected no undo log without snapshots, got %d", s.undoLog.GetSize())
+	}
+
+	other := NewMinMaxStack(10)
+	if other.Rollback(other.Snapshot()) != nil {
+		t.Error("Expected rollback to fresh snapshot to succeed")
+	}
+	if s.Rollback(other.Snapshot()) == nil {
+		t.Error("Expected error for snapshot of another stack")
+	}
+}
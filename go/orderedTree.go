@@ -0,0 +1,281 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+)
+
+// BinaryTree — общий набор команд деревьев пакета
+type BinaryTree interface {
+	TINSERT(key int, value string)
+	TDEL(key int)
+	ISMEMBER(key int) bool
+	TGET(key int) (string, bool)
+	PRINT_PREORDER() string
+	PRINT_INORDER() string
+	PRINT_POSTORDER() string
+	PRINT_BFS() string
+	Clear()
+}
+
+// OrderedNode — узел упорядоченного дерева; height используется AVL, red — красно-чёрным деревом
+type OrderedNode struct {
+	key    int
+	value  string
+	left   *OrderedNode
+	right  *OrderedNode
+	height int
+	red    bool
+}
+
+// orderedTree содержит общие для BST, AVL и красно-чёрного дерева запросы
+type orderedTree struct {
+	root *OrderedNode
+	size int
+}
+
+func (t *orderedTree) find(key int) *OrderedNode {
+	current := t.root
+	for current != nil {
+		if key < current.key {
+			current = current.left
+		} else if key > current.key {
+			current = current.right
+		} else {
+			return current
+		}
+	}
+	return nil
+}
+
+func (t *orderedTree) ISMEMBER(key int) bool {
+	return t.find(key) != nil
+}
+
+func (t *orderedTree) TGET(key int) (string, bool) {
+	if node := t.find(key); node != nil {
+		return node.value, true
+	}
+	return "", false
+}
+
+func (t *orderedTree) GetSize() int {
+	return t.size
+}
+
+func (t *orderedTree) Clear() {
+	t.root = nil
+	t.size = 0
+}
+
+func (t *orderedTree) Min() (int, bool) {
+	if t.root == nil {
+		return 0, false
+	}
+	return minOrderedNode(t.root).key, true
+}
+
+func (t *orderedTree) Max() (int, bool) {
+	if t.root == nil {
+		return 0, false
+	}
+	current := t.root
+	for current.right != nil {
+		current = current.right
+	}
+	return current.key, true
+}
+
+// Floor возвращает наибольший ключ, не превосходящий key
+func (t *orderedTree) Floor(key int) (int, bool) {
+	var best *OrderedNode
+	current := t.root
+	for current != nil {
+		if key < current.key {
+			current = current.left
+		} else {
+			best = current
+			current = current.right
+		}
+	}
+	if best == nil {
+		return 0, false
+	}
+	return best.key, true
+}
+
+// Ceiling возвращает наименьший ключ, не меньший key
+func (t *orderedTree) Ceiling(key int) (int, bool) {
+	var best *OrderedNode
+	current := t.root
+	for current != nil {
+		if key > current.key {
+			current = current.right
+		} else {
+			best = current
+			current = current.left
+		}
+	}
+	if best == nil {
+		return 0, false
+	}
+	return best.key, true
+}
+
+// Range возвращает по возрастанию ключи из отрезка [from, to]
+func (t *orderedTree) Range(from, to int) []int {
+	result := make([]int, 0)
+	collectRange(t.root, from, to, &result)
+	return result
+}
+
+func collectRange(node *OrderedNode, from, to int, result *[]int) {
+	if node == nil {
+		return
+	}
+	if from < node.key {
+		collectRange(node.left, from, to, result)
+	}
+	if from <= node.key && node.key <= to {
+		*result = append(*result, node.key)
+	}
+	if node.key < to {
+		collectRange(node.right, from, to, result)
+	}
+}
+
+func (t *orderedTree) PRINT_PREORDER() string {
+	res := make([]int, 0)
+	var walk func(node *OrderedNode)
+	walk = func(node *OrderedNode) {
+		if node == nil {
+			return
+		}
+		res = append(res, node.key)
+		walk(node.left)
+		walk(node.right)
+	}
+	walk(t.root)
+	return keysToString(res)
+}
+
+func (t *orderedTree) PRINT_INORDER() string {
+	res := make([]int, 0)
+	var walk func(node *OrderedNode)
+	walk = func(node *OrderedNode) {
+		if node == nil {
+			return
+		}
+		walk(node.left)
+		res = append(res, node.key)
+		walk(node.right)
+	}
+	walk(t.root)
+	return keysToString(res)
+}
+
+func (t *orderedTree) PRINT_POSTORDER() string {
+	res := make([]int, 0)
+	var walk func(node *OrderedNode)
+	walk = func(node *OrderedNode) {
+		if node == nil {
+			return
+		}
+		walk(node.left)
+		walk(node.right)
+		res = append(res, node.key)
+	}
+	walk(t.root)
+	return keysToString(res)
+}
+
+func (t *orderedTree) PRINT_BFS() string {
+	res := make([]int, 0)
+	if t.root != nil {
+		queue := []*OrderedNode{t.root}
+		for len(queue) > 0 {
+			current := queue[0]
+			queue = queue[1:]
+			res = append(res, current.key)
+			if current.left != nil {
+				queue = append(queue, current.left)
+			}
+			if current.right != nil {
+				queue = append(queue, current.right)
+			}
+		}
+	}
+	return keysToString(res)
+}
+
+func minOrderedNode(node *OrderedNode) *OrderedNode {
+	for node.left != nil {
+		node = node.left
+	}
+	return node
+}
+
+func keysToString(keys []int) string {
+	strs := make([]string, len(keys))
+	for i, v := range keys {
+		strs[i] = strconv.Itoa(v)
+	}
+	return strings.Join(strs, " ")
+}
+
+// BinarySearchTree — несбалансированное дерево поиска; повторная вставка ключа обновляет значение
+type BinarySearchTree struct {
+	orderedTree
+}
+
+func NewBinarySearchTree() *BinarySearchTree {
+	return &BinarySearchTree{}
+}
+
+func (t *BinarySearchTree) TINSERT(key int, value string) {
+	link := &t.root
+	for *link != nil {
+		if key < (*link).key {
+			link = &(*link).left
+		} else if key > (*link).key {
+			link = &(*link).right
+		} else {
+			(*link).value = value
+			return
+		}
+	}
+	*link = &OrderedNode{key: key, value: value}
+	t.size++
+}
+
+func (t *BinarySearchTree) TDEL(key int) {
+	link := &t.root
+	for *link != nil && (*link).key != key {
+		if key < (*link).key {
+			link = &(*link).left
+		} else {
+			link = &(*link).right
+		}
+	}
+	node := *link
+	if node == nil {
+		return
+	}
+
+	switch {
+	case node.left == nil:
+		*link = node.right
+	case node.right == nil:
+		*link = node.left
+	default:
+		succLink := &node.right
+		for (*succLink).left != nil {
+			succLink = &(*succLink).left
+		}
+		succ := *succLink
+		node.key = succ.key
+		node.value = succ.value
+		*succLink = succ.right
+	}
+	t.size--
+}
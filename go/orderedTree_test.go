@@ -0,0 +1,177 @@
+package main
+
+import (
+	"math/rand"
+	"sort"
+	"strconv"
+	"testing"
+)
+
+var (
+	_ BinaryTree = (*FullBinaryTree)(nil)
+	_ BinaryTree = (*BinarySearchTree)(nil)
+	_ BinaryTree = (*AVLTree)(nil)
+	_ BinaryTree = (*RedBlackTree)(nil)
+)
+
+// orderedTreeUnderTest даёт тестам доступ к общей части упорядоченных деревьев
+type orderedTreeUnderTest interface {
+	BinaryTree
+	GetSize() int
+	Min() (int, bool)
+	Max() (int, bool)
+	Floor(key int) (int, bool)
+	Ceiling(key int) (int, bool)
+	Range(from, to int) []int
+}
+
+func orderedTreesForTest() map[string]orderedTreeUnderTest {
+	return map[string]orderedTreeUnderTest{
+		"bst": NewBinarySearchTree(),
+		"avl": NewAVLTree(),
+		"rb":  NewRedBlackTree(),
+	}
+}
+
+func TestOrderedTreeCommands(t *testing.T) {
+	for name, tree := range orderedTreesForTest() {
+		for _, key := range []int{50, 30, 70, 20, 40, 60, 80} {
+			tree.TINSERT(key, strconv.Itoa(key))
+		}
+		tree.TINSERT(40, "forty")
+
+		if tree.GetSize() != 7 {
+			t.Errorf("%s: expected size 7, got %d", name, tree.GetSize())
+		}
+		if tree.PRINT_INORDER() != "20 30 40 50 60 70 80" {
+			t.Errorf("%s: unexpected inorder '%s'", name, tree.PRINT_INORDER())
+		}
+		if !tree.ISMEMBER(60) || tree.ISMEMBER(65) {
+			t.Errorf("%s: unexpected membership", name)
+		}
+		if val, ok := tree.TGET(60); !ok || val != "60" {
+			t.Errorf("%s: expected TGET(60) '60', got '%s'", name, val)
+		}
+		if _, ok := tree.TGET(65); ok {
+			t.Errorf("%s: expected TGET(65) to report missing key", name)
+		}
+		if len(getElements(tree.PRINT_PREORDER())) != 7 ||
+			len(getElements(tree.PRINT_POSTORDER())) != 7 ||
+			len(getElements(tree.PRINT_BFS())) != 7 {
+			t.Errorf("%s: expected 7 elements in every traversal", name)
+		}
+
+		if val, _ := tree.TGET(40); val != "forty" {
+			t.Errorf("%s: expected updated value 'forty', got '%s'", name, val)
+		}
+
+		tree.TDEL(50)
+		tree.TDEL(20)
+		tree.TDEL(999)
+		if tree.PRINT_INORDER() != "30 40 60 70 80" {
+			t.Errorf("%s: unexpected inorder after delete '%s'", name, tree.PRINT_INORDER())
+		}
+		if tree.GetSize() != 5 {
+			t.Errorf("%s: expected size 5, got %d", name, tree.GetSize())
+		}
+		if val, _ := tree.TGET(60); val != "60" {
+			t.Errorf("%s: expected value '60' after deletes, got '%s'", name, val)
+		}
+
+		tree.Clear()
+		if tree.PRINT_INORDER() != "" || tree.GetSize() != 0 {
+			t.Errorf("%s: expected empty tree after Clear", name)
+		}
+	}
+}
+
+func TestOrderedTreeQueries(t *testing.T) {
+	for name, tree := range orderedTreesForTest() {
+		if _, ok := tree.Min(); ok {
+			t.Errorf("%s: expected no Min in empty tree", name)
+		}
+		if _, ok := tree.Floor(1); ok {
+			t.Errorf("%s: expected no Floor in empty tree", name)
+		}
+
+		for _, key := range []int{10, 20, 30, 40, 50} {
+			tree.TINSERT(key, strconv.Itoa(key))
+		}
+
+		if v, _ := tree.Min(); v != 10 {
+			t.Errorf("%s: expected Min 10, got %d", name, v)
+		}
+		if v, _ := tree.Max(); v != 50 {
+			t.Errorf("%s: expected Max 50, got %d", name, v)
+		}
+		if v, ok := tree.Floor(35); !ok || v != 30 {
+			t.Errorf("%s: expected Floor(35) 30, got %d", name, v)
+		}
+		if v, ok := tree.Floor(30); !ok || v != 30 {
+			t.Errorf("%s: expected Floor(30) 30, got %d", name, v)
+		}
+		if _, ok := tree.Floor(5); ok {
+			t.Errorf("%s: expected no Floor(5)", name)
+		}
+		if v, ok := tree.Ceiling(35); !ok || v != 40 {
+			t.Errorf("%s: expected Ceiling(35) 40, got %d", name, v)
+		}
+		if _, ok := tree.Ceiling(55); ok {
+			t.Errorf("%s: expected no Ceiling(55)", name)
+		}
+
+		got := tree.Range(15, 40)
+		if len(got) != 3 || got[0] != 20 || got[2] != 40 {
+			t.Errorf("%s: unexpected Range(15, 40) %v", name, got)
+		}
+		if len(tree.Range(60, 70)) != 0 {
+			t.Errorf("%s: expected empty range", name)
+		}
+	}
+}
+
+func TestOrderedTreeRandomOperations(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for name, tree := range orderedTreesForTest() {
+		present := make(map[int]bool)
+		for i := 0; i < 2000; i++ {
+			key := r.Intn(300)
+			if r.Intn(3) == 0 {
+				tree.TDEL(key)
+				delete(present, key)
+			} else {
+				tree.TINSERT(key, strconv.Itoa(key))
+				present[key] = true
+			}
+		}
+
+		expected := make([]int, 0, len(present))
+		for key := range present {
+			expected = append(expected, key)
+		}
+		sort.Ints(expected)
+
+		if tree.PRINT_INORDER() != keysToString(expected) {
+			t.Errorf("%s: inorder does not match reference set", name)
+		}
+		if tree.GetSize() != len(expected) {
+			t.Errorf("%s: expected size %d, got %d", name, len(expected), tree.GetSize())
+		}
+	}
+}
+
+func TestBinarySearchTreeDegenerateInput(t *testing.T) {
+	tree := NewBinarySearchTree()
+	for i := 0; i < 10000; i++ {
+		tree.TINSERT(i, strconv.Itoa(i))
+	}
+	if !tree.ISMEMBER(9999) {
+		t.Error("Expected 9999 to be in tree")
+	}
+	for i := 0; i < 10000; i += 2 {
+		tree.TDEL(i)
+	}
+	if tree.GetSize() != 5000 {
+		t.Errorf("Expected size 5000, got %d", tree.GetSize())
+	}
+}
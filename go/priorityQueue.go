@@ -0,0 +1,324 @@
+package main
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// PQItem — дескриптор элемента очереди с приоритетом для Update и Remove
+type PQItem struct {
+	value    string
+	priority int
+	seq      int
+	index    int
+	queue    *PriorityQueue
+}
+
+func (it *PQItem) Value() string {
+	return it.value
+}
+
+func (it *PQItem) Priority() int {
+	return it.priority
+}
+
+// PriorityQueue — двоичная куча на срезе; элементы с равным приоритетом
+// извлекаются в порядке добавления
+type PriorityQueue struct {
+	data     []*PQItem
+	size     int
+	capacity int
+	maxHeap  bool
+	nextSeq  int
+}
+
+// NewMinPriorityQueue первым извлекает элемент с наименьшим приоритетом
+func NewMinPriorityQueue(initialCapacity int) *PriorityQueue {
+	return newPriorityQueue(initialCapacity, false)
+}
+
+// NewMaxPriorityQueue первым извлекает элемент с наибольшим приоритетом
+func NewMaxPriorityQueue(initialCapacity int) *PriorityQueue {
+	return newPriorityQueue(initialCapacity, true)
+}
+
+func newPriorityQueue(initialCapacity int, maxHeap bool) *PriorityQueue {
+	if initialCapacity <= 0 {
+		initialCapacity = 10
+	}
+	return &PriorityQueue{
+		data:     make([]*PQItem, initialCapacity),
+		capacity: initialCapacity,
+		maxHeap:  maxHeap,
+	}
+}
+
+func (pq *PriorityQueue) resizeTo(newCapacity int) {
+	newData := make([]*PQItem, newCapacity)
+	copy(newData, pq.data[:pq.size])
+	pq.data = newData
+	pq.capacity = newCapacity
+}
+
+func (pq *PriorityQueue) before(a, b *PQItem) bool {
+	if a.priority != b.priority {
+		if pq.maxHeap {
+			return a.priority > b.priority
+		}
+		return a.priority < b.priority
+	}
+	return a.seq < b.seq
+}
+
+func (pq *PriorityQueue) swap(i, j int) {
+	pq.data[i], pq.data[j] = pq.data[j], pq.data[i]
+	pq.data[i].index = i
+	pq.data[j].index = j
+}
+
+func (pq *PriorityQueue) siftUp(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !pq.before(pq.data[i], pq.data[parent]) {
+			return
+		}
+		pq.swap(i, parent)
+		i = parent
+	}
+}
+
+func (pq *PriorityQueue) siftDown(i int) {
+	for {
+		best := i
+		left, right := 2*i+1, 2*i+2
+		if left < pq.size && pq.before(pq.data[left], pq.data[best]) {
+			best = left
+		}
+		if right < pq.size && pq.before(pq.data[right], pq.data[best]) {
+			best = right
+		}
+		if best == i {
+			return
+		}
+		pq.swap(i, best)
+		i = best
+	}
+}
+
+func (pq *PriorityQueue) Push(value string, priority int) *PQItem {
+	if pq.size >= pq.capacity {
+		newCapacity := pq.capacity * 2
+		if newCapacity == 0 {
+			newCapacity = 1
+		}
+		pq.resizeTo(newCapacity)
+	}
+	item := &PQItem{value: value, priority: priority, seq: pq.nextSeq, index: pq.size, queue: pq}
+	pq.nextSeq++
+	pq.data[pq.size] = item
+	pq.size++
+	pq.siftUp(item.index)
+	return item
+}
+
+func (pq *PriorityQueue) Pop() string {
+	if pq.size == 0 {
+		return ""
+	}
+	item := pq.data[0]
+	pq.removeAt(0)
+	return item.value
+}
+
+func (pq *PriorityQueue) Peek() string {
+	if pq.size == 0 {
+		return ""
+	}
+	return pq.data[0].value
+}
+
+func (pq *PriorityQueue) Update(item *PQItem, priority int) error {
+	if item == nil || item.queue != pq {
+		return fmt.Errorf("item does not belong to queue")
+	}
+	item.priority = priority
+	pq.siftUp(item.index)
+	pq.siftDown(item.index)
+	return nil
+}
+
+func (pq *PriorityQueue) Remove(item *PQItem) error {
+	if item == nil || item.queue != pq {
+		return fmt.Errorf("item does not belong to queue")
+	}
+	pq.removeAt(item.index)
+	return nil
+}
+
+func (pq *PriorityQueue) removeAt(i int) {
+	item := pq.data[i]
+	last := pq.size - 1
+	if i != last {
+		pq.swap(i, last)
+	}
+	pq.data[last] = nil
+	pq.size--
+	if i != last {
+		pq.siftUp(i)
+		pq.siftDown(i)
+	}
+	item.index = -1
+	item.queue = nil
+
+	if newCapacity, ok := shrinkTarget(pq.size, pq.capacity); ok {
+		pq.resizeTo(newCapacity)
+	}
+}
+
+func (pq *PriorityQueue) GetSize() int {
+	return pq.size
+}
+
+// ordered возвращает элементы в порядке извлечения
+func (pq *PriorityQueue) ordered() []*PQItem {
+	items := make([]*PQItem, pq.size)
+	copy(items, pq.data[:pq.size])
+	sort.Slice(items, func(i, j int) bool {
+		return pq.before(items[i], items[j])
+	})
+	return items
+}
+
+func (pq *PriorityQueue) clear() {
+	for i := 0; i < pq.size; i++ {
+		pq.data[i].index = -1
+		pq.data[i].queue = nil
+		pq.data[i] = nil
+	}
+	pq.size = 0
+}
+
+// SaveToText пишет строки "приоритет значение" в порядке извлечения
+func (pq *PriorityQueue) SaveToText(filename string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	writer := bufio.NewWriter(file)
+	fmt.Fprintf(writer, "%d\n", pq.size)
+	for _, item := range pq.ordered() {
+		fmt.Fprintf(writer, "%d %s\n", item.priority, item.value)
+	}
+	return writer.Flush()
+}
+
+func (pq *PriorityQueue) LoadFromText(filename string) error {
+	file, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+
+	// Читаем первую строку - размер
+	if !scanner.Scan() {
+		return scanner.Err()
+	}
+
+	newSize, err := strconv.Atoi(scanner.Text())
+	if err != nil {
+		return err
+	}
+
+	pq.clear()
+
+	// Читаем остальные строки - приоритет и значение
+	for i := 0; i < newSize && scanner.Scan(); i++ {
+		priorityStr, value, _ := strings.Cut(scanner.Text(), " ")
+		priority, err := strconv.Atoi(priorityStr)
+		if err != nil {
+			return err
+		}
+		pq.Push(value, priority)
+	}
+
+	return scanner.Err()
+}
+
+func (pq *PriorityQueue) SaveToBinary(filename string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	err = binary.Write(file, binary.LittleEndian, int32(pq.size))
+	if err != nil {
+		return err
+	}
+
+	for _, item := range pq.ordered() {
+		err = binary.Write(file, binary.LittleEndian, int64(item.priority))
+		if err != nil {
+			return err
+		}
+		strBytes := []byte(item.value)
+		err = binary.Write(file, binary.LittleEndian, int32(len(strBytes)))
+		if err != nil {
+			return err
+		}
+		_, err = file.Write(strBytes)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (pq *PriorityQueue) LoadFromBinary(filename string) error {
+	file, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	var newSize int32
+	err = binary.Read(file, binary.LittleEndian, &newSize)
+	if err != nil {
+		return err
+	}
+
+	pq.clear()
+
+	for i := 0; i < int(newSize); i++ {
+		var priority int64
+		err = binary.Read(file, binary.LittleEndian, &priority)
+		if err != nil {
+			return err
+		}
+
+		var strLen int32
+		err = binary.Read(file, binary.LittleEndian, &strLen)
+		if err != nil {
+			return err
+		}
+
+		strBytes := make([]byte, strLen)
+		_, err = io.ReadFull(file, strBytes)
+		if err != nil {
+			return err
+		}
+
+		pq.Push(string(strBytes), int(priority))
+	}
+	return nil
+}
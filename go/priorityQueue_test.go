@@ -0,0 +1,141 @@
+package main
+
+import (
+	"os"
+	"strconv"
+	"testing"
+)
+
+func TestPriorityQueueMinOrder(t *testing.T) {
+	pq := NewMinPriorityQueue(2)
+	pq.Push("low", 5)
+	pq.Push("high", 1)
+	pq.Push("mid", 3)
+
+	if pq.Peek() != "high" {
+		t.Errorf("Expected 'high', got '%s'", pq.Peek())
+	}
+	for _, want := range []string{"high", "mid", "low"} {
+		if got := pq.Pop(); got != want {
+			t.Errorf("Expected '%s', got '%s'", want, got)
+		}
+	}
+	if pq.Pop() != "" || pq.Peek() != "" {
+		t.Error("Expected empty strings from empty queue")
+	}
+}
+
+func TestPriorityQueueMaxOrderStable(t *testing.T) {
+	pq := NewMaxPriorityQueue(10)
+	pq.Push("a", 1)
+	pq.Push("b", 2)
+	pq.Push("c", 2)
+	pq.Push("d", 1)
+	pq.Push("e", 2)
+
+	for _, want := range []string{"b", "c", "e", "a", "d"} {
+		if got := pq.Pop(); got != want {
+			t.Errorf("Expected '%s', got '%s'", want, got)
+		}
+	}
+}
+
+func TestPriorityQueueUpdateRemove(t *testing.T) {
+	pq := NewMinPriorityQueue(10)
+	a := pq.Push("a", 10)
+	b := pq.Push("b", 20)
+	c := pq.Push("c", 30)
+
+	if err := pq.Update(c, 1); err != nil {
+		t.Fatal(err)
+	}
+	if pq.Peek() != "c" {
+		t.Errorf("Expected 'c' after update, got '%s'", pq.Peek())
+	}
+	if c.Priority() != 1 || c.Value() != "c" {
+		t.Errorf("Unexpected handle state %s/%d", c.Value(), c.Priority())
+	}
+
+	if err := pq.Remove(a); err != nil {
+		t.Fatal(err)
+	}
+	if pq.GetSize() != 2 {
+		t.Errorf("Expected size 2, got %d", pq.GetSize())
+	}
+	if pq.Remove(a) == nil {
+		t.Error("Expected error for removed handle")
+	}
+	if pq.Update(a, 0) == nil {
+		t.Error("Expected error for removed handle")
+	}
+
+	other := NewMinPriorityQueue(10)
+	if other.Remove(b) == nil {
+		t.Error("Expected error for handle of another queue")
+	}
+
+	if pq.Pop() != "c" || pq.Pop() != "b" {
+		t.Error("Unexpected order after Remove")
+	}
+}
+
+func TestPriorityQueueManyElements(t *testing.T) {
+	pq := NewMinPriorityQueue(1)
+	for i := 999; i >= 0; i-- {
+		pq.Push(strconv.Itoa(i), i)
+	}
+	for i := 0; i < 1000; i++ {
+		if got := pq.Pop(); got != strconv.Itoa(i) {
+			t.Fatalf("Expected '%d', got '%s'", i, got)
+		}
+	}
+	if pq.capacity > minShrinkCapacity {
+		t.Errorf("Expected capacity to shrink, got %d", pq.capacity)
+	}
+}
+
+func TestPriorityQueueSaveLoad(t *testing.T) {
+	pq := NewMinPriorityQueue(10)
+	pq.Push("second job", 2)
+	pq.Push("first", 1)
+	pq.Push("also second", 2)
+	pq.Push("big", 1<<40)
+
+	err := pq.SaveToText("pq.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = pq.SaveToBinary("pq.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fromText := NewMinPriorityQueue(10)
+	err = fromText.LoadFromText("pq.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fromBinary := NewMinPriorityQueue(10)
+	err = fromBinary.LoadFromBinary("pq.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, loaded := range []*PriorityQueue{fromText, fromBinary} {
+		for _, want := range []string{"first", "second job", "also second", "big"} {
+			if got := loaded.Pop(); got != want {
+				t.Errorf("Expected '%s', got '%s'", want, got)
+			}
+		}
+	}
+
+	if fromText.LoadFromText("non_existing.txt") == nil {
+		t.Error("Expected error for non-existing file")
+	}
+	if fromBinary.LoadFromBinary("non_existing.bin") == nil {
+		t.Error("Expected error for non-existing file")
+	}
+
+	os.Remove("pq.txt")
+	os.Remove("pq.bin")
+}
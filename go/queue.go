@@ -32,6 +32,13 @@ func NewQueue(cap int) *Queue {
 
 func (q *Queue) resize() {
 	newCap := q.capacity * 2
+	if newCap == 0 {
+		newCap = 1
+	}
+	q.resizeTo(newCap)
+}
+
+func (q *Queue) resizeTo(newCap int) {
 	newData := make([]string, newCap)
 
 	for i := 0; i < q.size; i++ {
@@ -44,6 +51,28 @@ func (q *Queue) resize() {
 	q.rear = q.size - 1
 }
 
+func (q *Queue) shrink() {
+	if newCap, ok := shrinkTarget(q.size, q.capacity); ok {
+		q.resizeTo(newCap)
+	}
+}
+
+func (q *Queue) Cap() int {
+	return q.capacity
+}
+
+func (q *Queue) Reserve(n int) {
+	if n > q.capacity {
+		q.resizeTo(n)
+	}
+}
+
+func (q *Queue) ShrinkToFit() {
+	if q.capacity != q.size {
+		q.resizeTo(q.size)
+	}
+}
+
 func (q *Queue) Push(val string) {
 	if q.size == q.capacity {
 		q.resize()
@@ -58,8 +87,10 @@ func (q *Queue) Pop() string {
 		return ""
 	}
 	val := q.data[q.front]
+	q.data[q.front] = ""
 	q.front = (q.front + 1) % q.capacity
 	q.size--
+	q.shrink()
 	return val
 }
 
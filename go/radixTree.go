@@ -0,0 +1,222 @@
+package main
+
+import "strings"
+
+// radixNode хранит на входящем ребре целую подстроку label;
+// у всех узлов, кроме корня, label непуст
+type radixNode struct {
+	label    string
+	children map[byte]*radixNode
+	terminal bool
+}
+
+func newRadixNode(label string, terminal bool) *radixNode {
+	return &radixNode{label: label, children: make(map[byte]*radixNode), terminal: terminal}
+}
+
+// RadixTree — сжатое префиксное дерево: цепочки узлов с одним ребёнком
+// склеены в одно ребро, поэтому узлов намного меньше, чем у Trie
+type RadixTree struct {
+	root *radixNode
+	size int
+}
+
+func NewRadixTree() *RadixTree {
+	return &RadixTree{root: newRadixNode("", false)}
+}
+
+func commonPrefixLen(a, b string) int {
+	n := 0
+	for n < len(a) && n < len(b) && a[n] == b[n] {
+		n++
+	}
+	return n
+}
+
+// Insert добавляет ключ и возвращает false, если он уже был
+func (rt *RadixTree) Insert(key string) bool {
+	node := rt.root
+	rest := key
+	for rest != "" {
+		child := node.children[rest[0]]
+		if child == nil {
+			node.children[rest[0]] = newRadixNode(rest, true)
+			rt.size++
+			return true
+		}
+
+		common := commonPrefixLen(rest, child.label)
+		if common < len(child.label) {
+			// ключ расходится с ребром посередине: делим ребро
+			mid := newRadixNode(child.label[:common], false)
+			child.label = child.label[common:]
+			mid.children[child.label[0]] = child
+			node.children[rest[0]] = mid
+			child = mid
+		}
+		node = child
+		rest = rest[common:]
+	}
+
+	if node.terminal {
+		return false
+	}
+	node.terminal = true
+	rt.size++
+	return true
+}
+
+// mergeWithChild склеивает нетерминальный узел с его единственным ребёнком
+func (n *radixNode) mergeWithChild() {
+	for _, child := range n.children {
+		n.label += child.label
+		n.terminal = child.terminal
+		n.children = child.children
+	}
+}
+
+func (rt *RadixTree) Delete(key string) bool {
+	var parent *radixNode
+	node := rt.root
+	rest := key
+	for rest != "" {
+		child := node.children[rest[0]]
+		if child == nil || !strings.HasPrefix(rest, child.label) {
+			return false
+		}
+		parent, node = node, child
+		rest = rest[len(child.label):]
+	}
+	if !node.terminal {
+		return false
+	}
+	node.terminal = false
+	rt.size--
+
+	if node == rt.root {
+		return true
+	}
+	switch len(node.children) {
+	case 0:
+		delete(parent.children, node.label[0])
+		if parent != rt.root && !parent.terminal && len(parent.children) == 1 {
+			parent.mergeWithChild()
+		}
+	case 1:
+		node.mergeWithChild()
+	}
+	return true
+}
+
+// locate ищет узел, под которым лежат все ключи с префиксом prefix,
+// и возвращает его вместе с полной строкой пути до него
+func (rt *RadixTree) locate(prefix string) (*radixNode, string) {
+	node := rt.root
+	path := ""
+	rest := prefix
+	for rest != "" {
+		child := node.children[rest[0]]
+		if child == nil {
+			return nil, ""
+		}
+		common := commonPrefixLen(rest, child.label)
+		if common == len(rest) {
+			return child, path + child.label
+		}
+		if common < len(child.label) {
+			return nil, ""
+		}
+		node = child
+		path += child.label
+		rest = rest[common:]
+	}
+	return node, path
+}
+
+func (rt *RadixTree) Contains(key string) bool {
+	node, path := rt.locate(key)
+	return node != nil && path == key && node.terminal
+}
+
+func (rt *RadixTree) HasPrefix(prefix string) bool {
+	node, _ := rt.locate(prefix)
+	return node != nil && (node.terminal || len(node.children) > 0)
+}
+
+// KeysWithPrefix возвращает ключи с данным префиксом в лексикографическом порядке
+func (rt *RadixTree) KeysWithPrefix(prefix string) []string {
+	result := make([]string, 0)
+	if node, path := rt.locate(prefix); node != nil {
+		collectRadixKeys(node, path, &result)
+	}
+	return result
+}
+
+func collectRadixKeys(node *radixNode, path string, result *[]string) {
+	if node.terminal {
+		*result = append(*result, path)
+	}
+	for _, b := range sortedChildBytes(node.children) {
+		child := node.children[b]
+		collectRadixKeys(child, path+child.label, result)
+	}
+}
+
+func (rt *RadixTree) LongestPrefixOf(s string) (string, bool) {
+	node := rt.root
+	consumed := 0
+	best := -1
+	if node.terminal {
+		best = 0
+	}
+	for consumed < len(s) {
+		child := node.children[s[consumed]]
+		if child == nil || !strings.HasPrefix(s[consumed:], child.label) {
+			break
+		}
+		consumed += len(child.label)
+		node = child
+		if node.terminal {
+			best = consumed
+		}
+	}
+	if best < 0 {
+		return "", false
+	}
+	return s[:best], true
+}
+
+func (rt *RadixTree) GetSize() int {
+	return rt.size
+}
+
+func (rt *RadixTree) Clear() {
+	rt.root = newRadixNode("", false)
+	rt.size = 0
+}
+
+func (rt *RadixTree) SaveToText(filename string) error {
+	return prefixKeysArray(rt).SaveToText(filename)
+}
+
+func (rt *RadixTree) LoadFromText(filename string) error {
+	arr := NewArray(0)
+	if err := arr.LoadFromText(filename); err != nil {
+		return err
+	}
+	fillPrefixTree(rt, arr)
+	return nil
+}
+
+func (rt *RadixTree) SaveToBinary(filename string) error {
+	return prefixKeysArray(rt).SaveToBinary(filename)
+}
+
+func (rt *RadixTree) LoadFromBinary(filename string) error {
+	arr := NewArray(0)
+	if err := arr.LoadFromBinary(filename); err != nil {
+		return err
+	}
+	fillPrefixTree(rt, arr)
+	return nil
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"math/rand"
+	"os"
+	"slices"
+	"testing"
+)
+
+func countRadixNodes(node *radixNode) int {
+	count := 1
+	for _, child := range node.children {
+		count += countRadixNodes(child)
+	}
+	return count
+}
+
+func TestRadixTreeSplitsAndMergesEdges(t *testing.T) {
+	tree := NewRadixTree()
+	tree.Insert("romane")
+	tree.Insert("romanus")
+
+	roman := tree.root.children['r']
+	if roman == nil || roman.label != "roman" || roman.terminal {
+		t.Fatalf("Expected shared edge 'roman', got %+v", roman)
+	}
+	if countRadixNodes(tree.root) != 4 {
+		t.Errorf("Expected 4 nodes, got %d", countRadixNodes(tree.root))
+	}
+
+	tree.Delete("romanus")
+	if child := tree.root.children['r']; child.label != "romane" || !child.terminal || len(child.children) != 0 {
+		t.Errorf("Expected edges to merge back into 'romane', got %+v", child)
+	}
+
+	tree.Insert("roman")
+	tree.Insert("romanus")
+	tree.Delete("roman")
+	if child := tree.root.children['r']; child.label != "roman" || child.terminal || len(child.children) != 2 {
+		t.Errorf("Expected non-terminal 'roman' with two children, got %+v", child)
+	}
+}
+
+func TestRadixTreeMatchesTrie(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	trie := NewTrie()
+	radix := NewRadixTree()
+
+	randomKey := func() string {
+		b := make([]byte, rng.Intn(6))
+		for i := range b {
+			b[i] = "abc"[rng.Intn(3)]
+		}
+		return string(b)
+	}
+
+	for i := 0; i < 2000; i++ {
+		key := randomKey()
+		if rng.Intn(3) == 0 {
+			if trie.Delete(key) != radix.Delete(key) {
+				t.Fatalf("Delete(%q) disagrees", key)
+			}
+		} else if trie.Insert(key) != radix.Insert(key) {
+			t.Fatalf("Insert(%q) disagrees", key)
+		}
+
+		prefix := randomKey()
+		if !slices.Equal(trie.KeysWithPrefix(prefix), radix.KeysWithPrefix(prefix)) {
+			t.Fatalf("KeysWithPrefix(%q) disagrees: %v vs %v", prefix, trie.KeysWithPrefix(prefix), radix.KeysWithPrefix(prefix))
+		}
+		a, okA := trie.LongestPrefixOf(prefix)
+		b, okB := radix.LongestPrefixOf(prefix)
+		if a != b || okA != okB {
+			t.Fatalf("LongestPrefixOf(%q) disagrees: %q vs %q", prefix, a, b)
+		}
+	}
+	if trie.GetSize() != radix.GetSize() {
+		t.Errorf("Expected equal sizes, got %d and %d", trie.GetSize(), radix.GetSize())
+	}
+}
+
+func TestRadixTreeSaveLoad(t *testing.T) {
+	tree := NewRadixTree()
+	fillWords(tree)
+
+	if err := tree.SaveToBinary("radix.bin"); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove("radix.bin")
+
+	loaded := NewRadixTree()
+	if err := loaded.LoadFromBinary("radix.bin"); err != nil {
+		t.Fatal(err)
+	}
+	if got := loaded.KeysWithPrefix(""); !slices.Equal(got, tree.KeysWithPrefix("")) {
+		t.Errorf("Expected %v, got %v", tree.KeysWithPrefix(""), got)
+	}
+	if err := loaded.LoadFromText("missing.txt"); err == nil {
+		t.Error("Expected error for missing file")
+	}
+}
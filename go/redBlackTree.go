@@ -0,0 +1,150 @@
+package main
+
+// RedBlackTree — левостороннее красно-чёрное дерево (LLRB Седжвика):
+// красные связи бывают только левыми, поэтому удаление обходится без ссылок на родителя
+type RedBlackTree struct {
+	orderedTree
+}
+
+func NewRedBlackTree() *RedBlackTree {
+	return &RedBlackTree{}
+}
+
+func (t *RedBlackTree) TINSERT(key int, value string) {
+	var inserted bool
+	t.root, inserted = rbInsert(t.root, key, value)
+	t.root.red = false
+	if inserted {
+		t.size++
+	}
+}
+
+func (t *RedBlackTree) TDEL(key int) {
+	if !t.ISMEMBER(key) {
+		return
+	}
+	if !rbIsRed(t.root.left) && !rbIsRed(t.root.right) {
+		t.root.red = true
+	}
+	t.root = rbDelete(t.root, key)
+	if t.root != nil {
+		t.root.red = false
+	}
+	t.size--
+}
+
+func rbIsRed(node *OrderedNode) bool {
+	return node != nil && node.red
+}
+
+func rbRotateLeft(h *OrderedNode) *OrderedNode {
+	x := h.right
+	h.right = x.left
+	x.left = h
+	x.red = h.red
+	h.red = true
+	return x
+}
+
+func rbRotateRight(h *OrderedNode) *OrderedNode {
+	x := h.left
+	h.left = x.right
+	x.right = h
+	x.red = h.red
+	h.red = true
+	return x
+}
+
+func rbFlipColors(h *OrderedNode) {
+	h.red = !h.red
+	h.left.red = !h.left.red
+	h.right.red = !h.right.red
+}
+
+func rbFixUp(h *OrderedNode) *OrderedNode {
+	if rbIsRed(h.right) && !rbIsRed(h.left) {
+		h = rbRotateLeft(h)
+	}
+	if rbIsRed(h.left) && rbIsRed(h.left.left) {
+		h = rbRotateRight(h)
+	}
+	if rbIsRed(h.left) && rbIsRed(h.right) {
+		rbFlipColors(h)
+	}
+	return h
+}
+
+func rbInsert(h *OrderedNode, key int, value string) (*OrderedNode, bool) {
+	if h == nil {
+		return &OrderedNode{key: key, value: value, red: true}, true
+	}
+	var inserted bool
+	if key < h.key {
+		h.left, inserted = rbInsert(h.left, key, value)
+	} else if key > h.key {
+		h.right, inserted = rbInsert(h.right, key, value)
+	} else {
+		h.value = value
+		return h, false
+	}
+	return rbFixUp(h), inserted
+}
+
+func rbMoveRedLeft(h *OrderedNode) *OrderedNode {
+	rbFlipColors(h)
+	if rbIsRed(h.right.left) {
+		h.right = rbRotateRight(h.right)
+		h = rbRotateLeft(h)
+		rbFlipColors(h)
+	}
+	return h
+}
+
+func rbMoveRedRight(h *OrderedNode) *OrderedNode {
+	rbFlipColors(h)
+	if rbIsRed(h.left.left) {
+		h = rbRotateRight(h)
+		rbFlipColors(h)
+	}
+	return h
+}
+
+func rbDeleteMin(h *OrderedNode) *OrderedNode {
+	if h.left == nil {
+		return nil
+	}
+	if !rbIsRed(h.left) && !rbIsRed(h.left.left) {
+		h = rbMoveRedLeft(h)
+	}
+	h.left = rbDeleteMin(h.left)
+	return rbFixUp(h)
+}
+
+// rbDelete требует, чтобы ключ присутствовал в дереве
+func rbDelete(h *OrderedNode, key int) *OrderedNode {
+	if key < h.key {
+		if !rbIsRed(h.left) && !rbIsRed(h.left.left) {
+			h = rbMoveRedLeft(h)
+		}
+		h.left = rbDelete(h.left, key)
+	} else {
+		if rbIsRed(h.left) {
+			h = rbRotateRight(h)
+		}
+		if key == h.key && h.right == nil {
+			return nil
+		}
+		if !rbIsRed(h.right) && !rbIsRed(h.right.left) {
+			h = rbMoveRedRight(h)
+		}
+		if key == h.key {
+			succ := minOrderedNode(h.right)
+			h.key = succ.key
+			h.value = succ.value
+			h.right = rbDeleteMin(h.right)
+		} else {
+			h.right = rbDelete(h.right, key)
+		}
+	}
+	return rbFixUp(h)
+}
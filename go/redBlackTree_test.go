@@ -0,0 +1,66 @@
+package main
+
+import (
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+// checkRedBlack проверяет инварианты LLRB и возвращает чёрную высоту
+func checkRedBlack(t *testing.T, node *OrderedNode) int {
+	if node == nil {
+		return 1
+	}
+	if rbIsRed(node.right) {
+		t.Fatalf("Node %d has a red right link", node.key)
+	}
+	if rbIsRed(node) && rbIsRed(node.left) {
+		t.Fatalf("Node %d has two red links in a row", node.key)
+	}
+	left := checkRedBlack(t, node.left)
+	right := checkRedBlack(t, node.right)
+	if left != right {
+		t.Fatalf("Node %d has unequal black heights %d and %d", node.key, left, right)
+	}
+	if !node.red {
+		left++
+	}
+	return left
+}
+
+func TestRedBlackTreeInvariants(t *testing.T) {
+	tree := NewRedBlackTree()
+	for i := 0; i < 1000; i++ {
+		tree.TINSERT(i, strconv.Itoa(i))
+	}
+	checkRedBlack(t, tree.root)
+	if rbIsRed(tree.root) {
+		t.Error("Expected black root")
+	}
+
+	r := rand.New(rand.NewSource(11))
+	for i := 0; i < 3000; i++ {
+		key := r.Intn(1500)
+		if r.Intn(2) == 0 {
+			tree.TDEL(key)
+		} else {
+			tree.TINSERT(key, strconv.Itoa(key))
+		}
+		checkRedBlack(t, tree.root)
+	}
+}
+
+func TestRedBlackTreeDeleteAll(t *testing.T) {
+	tree := NewRedBlackTree()
+	for i := 0; i < 100; i++ {
+		tree.TINSERT(i, strconv.Itoa(i))
+	}
+	for i := 0; i < 100; i++ {
+		tree.TDEL(i)
+		checkRedBlack(t, tree.root)
+	}
+	if tree.root != nil || tree.GetSize() != 0 {
+		t.Error("Expected empty tree")
+	}
+	tree.TDEL(1)
+}
@@ -0,0 +1,144 @@
+package main
+
+// Sequence — общий интерфейс последовательных контейнеров.
+// Each обходит элементы по порядку, пока fn возвращает true.
+type Sequence interface {
+	Each(fn func(index int, value string) bool)
+}
+
+func (a *Array) Each(fn func(index int, value string) bool) {
+	for i := 0; i < a.size; i++ {
+		if !fn(i, a.data[i]) {
+			return
+		}
+	}
+}
+
+func (sl *SinglyList) Each(fn func(index int, value string) bool) {
+	i := 0
+	for current := sl.head; current != nil; current = current.next {
+		if !fn(i, current.data) {
+			return
+		}
+		i++
+	}
+}
+
+func (dl *DoublyList) Each(fn func(index int, value string) bool) {
+	i := 0
+	for current := dl.head; current != nil; current = current.next {
+		if !fn(i, current.data) {
+			return
+		}
+		i++
+	}
+}
+
+// Each обходит стек от дна к вершине
+func (s *Stack) Each(fn func(index int, value string) bool) {
+	for i := 0; i < s.size; i++ {
+		if !fn(i, s.data[i]) {
+			return
+		}
+	}
+}
+
+// Each обходит очередь от головы к хвосту
+func (q *Queue) Each(fn func(index int, value string) bool) {
+	for i := 0; i < q.size; i++ {
+		if !fn(i, q.data[(q.front+i)%q.capacity]) {
+			return
+		}
+	}
+}
+
+func Map(seq Sequence, fn func(string) string) *Array {
+	result := NewArray(0)
+	seq.Each(func(_ int, value string) bool {
+		result.PushBack(fn(value))
+		return true
+	})
+	return result
+}
+
+func Filter(seq Sequence, pred func(string) bool) *Array {
+	result := NewArray(0)
+	seq.Each(func(_ int, value string) bool {
+		if pred(value) {
+			result.PushBack(value)
+		}
+		return true
+	})
+	return result
+}
+
+func Reduce[T any](seq Sequence, initial T, fn func(acc T, value string) T) T {
+	acc := initial
+	seq.Each(func(_ int, value string) bool {
+		acc = fn(acc, value)
+		return true
+	})
+	return acc
+}
+
+func Any(seq Sequence, pred func(string) bool) bool {
+	return FindIndex(seq, pred) != -1
+}
+
+func All(seq Sequence, pred func(string) bool) bool {
+	return FindIndex(seq, func(value string) bool {
+		return !pred(value)
+	}) == -1
+}
+
+func Count(seq Sequence, pred func(string) bool) int {
+	count := 0
+	seq.Each(func(_ int, value string) bool {
+		if pred(value) {
+			count++
+		}
+		return true
+	})
+	return count
+}
+
+func FindIndex(seq Sequence, pred func(string) bool) int {
+	found := -1
+	seq.Each(func(index int, value string) bool {
+		if pred(value) {
+			found = index
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+// Partition делит элементы на подходящие под условие и остальные
+func Partition(seq Sequence, pred func(string) bool) (*Array, *Array) {
+	matched := NewArray(0)
+	rest := NewArray(0)
+	seq.Each(func(_ int, value string) bool {
+		if pred(value) {
+			matched.PushBack(value)
+		} else {
+			rest.PushBack(value)
+		}
+		return true
+	})
+	return matched, rest
+}
+
+// Distinct оставляет первое вхождение каждого значения
+func Distinct(seq Sequence) *Array {
+	result := NewArray(0)
+	seen := make(map[string]bool)
+	seq.Each(func(_ int, value string) bool {
+		if !seen[value] {
+			seen[value] = true
+			result.PushBack(value)
+		}
+		return true
+	})
+	return result
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func arrayToSlice(arr *Array) []string {
+	result := make([]string, 0, arr.GetSize())
+	for i := 0; i < arr.GetSize(); i++ {
+		val, _ := arr.Get(i)
+		result = append(result, val)
+	}
+	return result
+}
+
+func equalSlices(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestSequenceMapFilterAllContainers(t *testing.T) {
+	arr := NewArray(10)
+	sl := NewSinglyList()
+	dl := NewDoublyList()
+	st := NewStack(10)
+	q := NewQueue(10)
+	for _, v := range []string{"a", "bb", "c"} {
+		arr.PushBack(v)
+		sl.PushBack(v)
+		dl.PushBack(v)
+		st.Push(v)
+		q.Push(v)
+	}
+
+	for _, seq := range []Sequence{arr, sl, dl, st, q} {
+		mapped := arrayToSlice(Map(seq, strings.ToUpper))
+		if !equalSlices(mapped, []string{"A", "BB", "C"}) {
+			t.Errorf("Unexpected Map result %v", mapped)
+		}
+
+		filtered := arrayToSlice(Filter(seq, func(v string) bool { return len(v) == 1 }))
+		if !equalSlices(filtered, []string{"a", "c"}) {
+			t.Errorf("Unexpected Filter result %v", filtered)
+		}
+	}
+}
+
+func TestSequenceReduceCount(t *testing.T) {
+	sl := NewSinglyList()
+	sl.PushBack("ab")
+	sl.PushBack("cde")
+	sl.PushBack("f")
+
+	total := Reduce(sl, 0, func(acc int, v string) int { return acc + len(v) })
+	if total != 6 {
+		t.Errorf("Expected total length 6, got %d", total)
+	}
+
+	joined := Reduce(sl, "", func(acc string, v string) string { return acc + v })
+	if joined != "abcdef" {
+		t.Errorf("Expected 'abcdef', got '%s'", joined)
+	}
+
+	if Count(sl, func(v string) bool { return len(v) > 1 }) != 2 {
+		t.Error("Expected 2 elements longer than 1")
+	}
+}
+
+func TestSequenceAnyAllFindIndex(t *testing.T) {
+	dl := NewDoublyList()
+	dl.PushBack("x")
+	dl.PushBack("y")
+	dl.PushBack("z")
+
+	isY := func(v string) bool { return v == "y" }
+	if !Any(dl, isY) {
+		t.Error("Expected Any to find 'y'")
+	}
+	if All(dl, isY) {
+		t.Error("Expected All to be false")
+	}
+	if !All(dl, func(v string) bool { return len(v) == 1 }) {
+		t.Error("Expected All to be true")
+	}
+	if FindIndex(dl, isY) != 1 {
+		t.Errorf("Expected index 1, got %d", FindIndex(dl, isY))
+	}
+	if FindIndex(NewDoublyList(), isY) != -1 {
+		t.Error("Expected -1 on empty list")
+	}
+	if !All(NewDoublyList(), isY) {
+		t.Error("Expected All to be true on empty list")
+	}
+}
+
+func TestSequencePartitionDistinct(t *testing.T) {
+	arr := NewArray(10)
+	arr.AppendAll("1", "2", "1", "3", "2")
+
+	odd, even := Partition(arr, func(v string) bool { return v != "2" })
+	if !equalSlices(arrayToSlice(odd), []string{"1", "1", "3"}) {
+		t.Errorf("Unexpected matched part %v", arrayToSlice(odd))
+	}
+	if !equalSlices(arrayToSlice(even), []string{"2", "2"}) {
+		t.Errorf("Unexpected rest part %v", arrayToSlice(even))
+	}
+
+	distinct := arrayToSlice(Distinct(arr))
+	if !equalSlices(distinct, []string{"1", "2", "3"}) {
+		t.Errorf("Unexpected Distinct result %v", distinct)
+	}
+}
+
+func TestSequenceQueueWrapAround(t *testing.T) {
+	q := NewQueue(3)
+	q.Push("a")
+	q.Push("b")
+	q.Push("c")
+	q.Pop()
+	q.Push("d")
+
+	values := arrayToSlice(Map(q, func(v string) string { return v }))
+	if !equalSlices(values, []string{"b", "c", "d"}) {
+		t.Errorf("Unexpected queue order %v", values)
+	}
+}
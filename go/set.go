@@ -0,0 +1,200 @@
+package main
+
+import (
+	"iter"
+	"slices"
+)
+
+// StringSet — общий набор операций множеств строк; алгебраические операции
+// Set и SortedSet принимают любую реализацию, поэтому их можно смешивать
+type StringSet interface {
+	Add(value string) bool
+	Remove(value string) bool
+	Contains(value string) bool
+	GetSize() int
+	Clear()
+	Each(fn func(string) bool)
+}
+
+// Set — множество строк на хеш-таблице; порядок Each не определён,
+// а Values и файлы перечисляют элементы по возрастанию
+type Set struct {
+	items map[string]struct{}
+}
+
+func NewSet(values ...string) *Set {
+	s := &Set{items: make(map[string]struct{}, len(values))}
+	for _, v := range values {
+		s.Add(v)
+	}
+	return s
+}
+
+// Add добавляет элемент и возвращает false, если он уже был
+func (s *Set) Add(value string) bool {
+	if _, ok := s.items[value]; ok {
+		return false
+	}
+	s.items[value] = struct{}{}
+	return true
+}
+
+func (s *Set) Remove(value string) bool {
+	if _, ok := s.items[value]; !ok {
+		return false
+	}
+	delete(s.items, value)
+	return true
+}
+
+func (s *Set) Contains(value string) bool {
+	_, ok := s.items[value]
+	return ok
+}
+
+func (s *Set) GetSize() int {
+	return len(s.items)
+}
+
+func (s *Set) Clear() {
+	s.items = make(map[string]struct{})
+}
+
+func (s *Set) Each(fn func(string) bool) {
+	for v := range s.items {
+		if !fn(v) {
+			return
+		}
+	}
+}
+
+func (s *Set) All() iter.Seq[string] {
+	return s.Each
+}
+
+func (s *Set) Values() []string {
+	values := make([]string, 0, len(s.items))
+	for v := range s.items {
+		values = append(values, v)
+	}
+	slices.Sort(values)
+	return values
+}
+
+func (s *Set) Union(other StringSet) *Set {
+	result := NewSet()
+	unionInto(result, s, other)
+	return result
+}
+
+func (s *Set) Intersection(other StringSet) *Set {
+	result := NewSet()
+	intersectionInto(result, s, other)
+	return result
+}
+
+func (s *Set) Difference(other StringSet) *Set {
+	result := NewSet()
+	differenceInto(result, s, other)
+	return result
+}
+
+func (s *Set) SymmetricDifference(other StringSet) *Set {
+	result := NewSet()
+	differenceInto(result, s, other)
+	differenceInto(result, other, s)
+	return result
+}
+
+// IsSubset сообщает, содержится ли каждый элемент s в other
+func (s *Set) IsSubset(other StringSet) bool {
+	return isSubset(s, other)
+}
+
+func (s *Set) Equal(other StringSet) bool {
+	return s.GetSize() == other.GetSize() && isSubset(s, other)
+}
+
+func unionInto(dst, a, b StringSet) {
+	add := func(v string) bool {
+		dst.Add(v)
+		return true
+	}
+	a.Each(add)
+	b.Each(add)
+}
+
+func intersectionInto(dst, a, b StringSet) {
+	if a.GetSize() > b.GetSize() {
+		a, b = b, a
+	}
+	a.Each(func(v string) bool {
+		if b.Contains(v) {
+			dst.Add(v)
+		}
+		return true
+	})
+}
+
+func differenceInto(dst, a, b StringSet) {
+	a.Each(func(v string) bool {
+		if !b.Contains(v) {
+			dst.Add(v)
+		}
+		return true
+	})
+}
+
+func isSubset(a, b StringSet) bool {
+	if a.GetSize() > b.GetSize() {
+		return false
+	}
+	subset := true
+	a.Each(func(v string) bool {
+		subset = b.Contains(v)
+		return subset
+	})
+	return subset
+}
+
+// Файлы множеств совпадают с файлами Array: элементы по возрастанию
+// через Array.SaveToText/SaveToBinary
+
+func setValuesArray(values []string) *Array {
+	arr := NewArray(len(values))
+	arr.AppendAll(values...)
+	return arr
+}
+
+func fillSet(s StringSet, arr *Array) {
+	s.Clear()
+	for v := range arr.All() {
+		s.Add(v)
+	}
+}
+
+func (s *Set) SaveToText(filename string) error {
+	return setValuesArray(s.Values()).SaveToText(filename)
+}
+
+func (s *Set) LoadFromText(filename string) error {
+	arr := NewArray(0)
+	if err := arr.LoadFromText(filename); err != nil {
+		return err
+	}
+	fillSet(s, arr)
+	return nil
+}
+
+func (s *Set) SaveToBinary(filename string) error {
+	return setValuesArray(s.Values()).SaveToBinary(filename)
+}
+
+func (s *Set) LoadFromBinary(filename string) error {
+	arr := NewArray(0)
+	if err := arr.LoadFromBinary(filename); err != nil {
+		return err
+	}
+	fillSet(s, arr)
+	return nil
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"os"
+	"slices"
+	"testing"
+)
+
+var (
+	_ StringSet = (*Set)(nil)
+	_ StringSet = (*SortedSet)(nil)
+)
+
+// setValues возвращает элементы любой реализации по возрастанию
+func setValues(s StringSet) []string {
+	values := make([]string, 0, s.GetSize())
+	s.Each(func(v string) bool {
+		values = append(values, v)
+		return true
+	})
+	slices.Sort(values)
+	return values
+}
+
+type setOps struct {
+	newSet              func(values ...string) StringSet
+	union               func(a StringSet, b StringSet) StringSet
+	intersection        func(a StringSet, b StringSet) StringSet
+	difference          func(a StringSet, b StringSet) StringSet
+	symmetricDifference func(a StringSet, b StringSet) StringSet
+	isSubset            func(a StringSet, b StringSet) bool
+}
+
+func setImplementations() map[string]setOps {
+	return map[string]setOps{
+		"hash": {
+			newSet:              func(values ...string) StringSet { return NewSet(values...) },
+			union:               func(a, b StringSet) StringSet { return a.(*Set).Union(b) },
+			intersection:        func(a, b StringSet) StringSet { return a.(*Set).Intersection(b) },
+			difference:          func(a, b StringSet) StringSet { return a.(*Set).Difference(b) },
+			symmetricDifference: func(a, b StringSet) StringSet { return a.(*Set).SymmetricDifference(b) },
+			isSubset:            func(a, b StringSet) bool { return a.(*Set).IsSubset(b) },
+		},
+		"sorted": {
+			newSet:              func(values ...string) StringSet { return NewSortedSet(values...) },
+			union:               func(a, b StringSet) StringSet { return a.(*SortedSet).Union(b) },
+			intersection:        func(a, b StringSet) StringSet { return a.(*SortedSet).Intersection(b) },
+			difference:          func(a, b StringSet) StringSet { return a.(*SortedSet).Difference(b) },
+			symmetricDifference: func(a, b StringSet) StringSet { return a.(*SortedSet).SymmetricDifference(b) },
+			isSubset:            func(a, b StringSet) bool { return a.(*SortedSet).IsSubset(b) },
+		},
+	}
+}
+
+func TestSetAddRemoveContains(t *testing.T) {
+	for name, ops := range setImplementations() {
+		s := ops.newSet("a", "b", "a")
+
+		if s.GetSize() != 2 {
+			t.Errorf("%s: expected size 2, got %d", name, s.GetSize())
+		}
+		if s.Add("b") || !s.Add("c") {
+			t.Errorf("%s: unexpected Add result", name)
+		}
+		if !s.Contains("c") || s.Contains("d") {
+			t.Errorf("%s: unexpected Contains result", name)
+		}
+		if !s.Remove("a") || s.Remove("a") {
+			t.Errorf("%s: expected a to be removed exactly once", name)
+		}
+		if got := setValues(s); !slices.Equal(got, []string{"b", "c"}) {
+			t.Errorf("%s: expected [b c], got %v", name, got)
+		}
+
+		s.Clear()
+		if s.GetSize() != 0 {
+			t.Errorf("%s: expected empty set after Clear", name)
+		}
+	}
+}
+
+func TestSetAlgebra(t *testing.T) {
+	for name, ops := range setImplementations() {
+		a := ops.newSet("1", "2", "3", "4")
+		b := ops.newSet("3", "4", "5")
+
+		cases := map[string]struct {
+			got  StringSet
+			want []string
+		}{
+			"union":                {ops.union(a, b), []string{"1", "2", "3", "4", "5"}},
+			"intersection":         {ops.intersection(a, b), []string{"3", "4"}},
+			"difference":           {ops.difference(a, b), []string{"1", "2"}},
+			"symmetric difference": {ops.symmetricDifference(a, b), []string{"1", "2", "5"}},
+		}
+		for op, c := range cases {
+			if got := setValues(c.got); !slices.Equal(got, c.want) {
+				t.Errorf("%s %s: expected %v, got %v", name, op, c.want, got)
+			}
+		}
+
+		if got := setValues(a); !slices.Equal(got, []string{"1", "2", "3", "4"}) {
+			t.Errorf("%s: operations changed the receiver: %v", name, got)
+		}
+
+		if !ops.isSubset(ops.newSet("3", "4"), a) || ops.isSubset(b, a) {
+			t.Errorf("%s: unexpected IsSubset result", name)
+		}
+		if !ops.isSubset(ops.newSet(), a) || !ops.isSubset(a, a) {
+			t.Errorf("%s: expected empty set and itself to be subsets", name)
+		}
+	}
+}
+
+func TestSetMixedImplementations(t *testing.T) {
+	hash := NewSet("x", "y")
+	sorted := NewSortedSet("y", "z")
+
+	if got := hash.Union(sorted).Values(); !slices.Equal(got, []string{"x", "y", "z"}) {
+		t.Errorf("Expected [x y z], got %v", got)
+	}
+	if got := sorted.Intersection(hash).Values(); !slices.Equal(got, []string{"y"}) {
+		t.Errorf("Expected [y], got %v", got)
+	}
+	if !hash.Equal(NewSortedSet("y", "x")) || hash.Equal(sorted) {
+		t.Error("Unexpected Equal result")
+	}
+}
+
+func TestSetSaveLoad(t *testing.T) {
+	s := NewSet("pear", "apple", "with space")
+
+	if err := s.SaveToText("set.txt"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SaveToBinary("set.bin"); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove("set.txt")
+	defer os.Remove("set.bin")
+
+	content, _ := os.ReadFile("set.txt")
+	if string(content) != "3\napple\npear\nwith space\n" {
+		t.Errorf("Unexpected file content:\n%s", content)
+	}
+
+	fromText := NewSet("stale")
+	if err := fromText.LoadFromText("set.txt"); err != nil {
+		t.Fatal(err)
+	}
+	fromBinary := NewSortedSet()
+	if err := fromBinary.LoadFromBinary("set.bin"); err != nil {
+		t.Fatal(err)
+	}
+	if !fromText.Equal(s) || !fromBinary.Equal(s) {
+		t.Errorf("Expected %v, got %v and %v", s.Values(), fromText.Values(), fromBinary.Values())
+	}
+}
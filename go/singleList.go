@@ -50,7 +50,7 @@ func (sl *SinglyList) PushBack(val string) {
 	sl.size++
 }
 
-func (sl *SinglyList) InsertAfter(target, val string) {
+func (sl *SinglyList) InsertAfter(target, val string) error {
 	current := sl.head
 	for current != nil {
 		if current.data == target {
@@ -61,20 +61,42 @@ func (sl *SinglyList) InsertAfter(target, val string) {
 				sl.tail = newNode
 			}
 			sl.size++
-			return
+			return nil
 		}
 		current = current.next
 	}
+	return ErrNotFound
 }
 
-func (sl *SinglyList) InsertBefore(target, val string) {
+// InsertAfterAll вставляет val после каждого вхождения target и возвращает число вставок
+func (sl *SinglyList) InsertAfterAll(target, val string) int {
+	count := 0
+	current := sl.head
+	for current != nil {
+		if current.data == target {
+			newNode := &SNode{data: val}
+			newNode.next = current.next
+			current.next = newNode
+			if current == sl.tail {
+				sl.tail = newNode
+			}
+			sl.size++
+			count++
+			current = newNode
+		}
+		current = current.next
+	}
+	return count
+}
+
+func (sl *SinglyList) InsertBefore(target, val string) error {
 	if sl.head == nil {
-		return
+		return ErrNotFound
 	}
 
 	if sl.head.data == target {
 		sl.PushFront(val)
-		return
+		return nil
 	}
 
 	current := sl.head
@@ -84,10 +106,11 @@ func (sl *SinglyList) InsertBefore(target, val string) {
 			newNode.next = current.next
 			current.next = newNode
 			sl.size++
-			return
+			return nil
 		}
 		current = current.next
 	}
+	return ErrNotFound
 }
 
 func (sl *SinglyList) PopFront() {
@@ -101,6 +124,8 @@ func (sl *SinglyList) PopFront() {
 	sl.size--
 }
 
+// PopBack работает за O(n): у односвязного списка нет ссылки на предпоследний узел.
+// Для частого удаления с конца используйте DoublyList, где PopBack выполняется за O(1).
 func (sl *SinglyList) PopBack() {
 	if sl.head == nil {
 		return
@@ -119,14 +144,14 @@ func (sl *SinglyList) PopBack() {
 	sl.size--
 }
 
-func (sl *SinglyList) RemoveByValue(val string) {
+func (sl *SinglyList) RemoveByValue(val string) error {
 	if sl.head == nil {
-		return
+		return ErrNotFound
 	}
 
 	if sl.head.data == val {
 		sl.PopFront()
-		return
+		return nil
 	}
 
 	current := sl.head
@@ -138,10 +163,229 @@ func (sl *SinglyList) RemoveByValue(val string) {
 				sl.tail = current
 			}
 			sl.size--
-			return
+			return nil
 		}
 		current = current.next
 	}
+	return ErrNotFound
+}
+
+// RemoveAll удаляет все вхождения val и возвращает их количество
+func (sl *SinglyList) RemoveAll(val string) int {
+	removed := 0
+	for sl.head != nil && sl.head.data == val {
+		sl.PopFront()
+		removed++
+	}
+	if sl.head == nil {
+		return removed
+	}
+
+	current := sl.head
+	for current.next != nil {
+		if current.next.data == val {
+			temp := current.next
+			current.next = temp.next
+			if temp == sl.tail {
+				sl.tail = current
+			}
+			sl.size--
+			removed++
+		} else {
+			current = current.next
+		}
+	}
+	return removed
+}
+
+func (sl *SinglyList) IndexOf(val string) int {
+	index := 0
+	for current := sl.head; current != nil; current = current.next {
+		if current.data == val {
+			return index
+		}
+		index++
+	}
+	return -1
+}
+
+func (sl *SinglyList) LastIndexOf(val string) int {
+	found := -1
+	index := 0
+	for current := sl.head; current != nil; current = current.next {
+		if current.data == val {
+			found = index
+		}
+		index++
+	}
+	return found
+}
+
+func (sl *SinglyList) CountOf(val string) int {
+	count := 0
+	for current := sl.head; current != nil; current = current.next {
+		if current.data == val {
+			count++
+		}
+	}
+	return count
+}
+
+func (sl *SinglyList) Reverse() {
+	var prev *SNode
+	current := sl.head
+	sl.tail = sl.head
+	for current != nil {
+		next := current.next
+		current.next = prev
+		prev = current
+		current = next
+	}
+	sl.head = prev
+}
+
+// Splice переносит все узлы other в конец списка за O(1), оставляя other пустым
+func (sl *SinglyList) Splice(other *SinglyList) {
+	if other == sl || other.head == nil {
+		return
+	}
+	if sl.head == nil {
+		sl.head = other.head
+	} else {
+		sl.tail.next = other.head
+	}
+	sl.tail = other.tail
+	sl.size += other.size
+	other.Clear()
+}
+
+// SplitAt оставляет в списке первые n элементов и возвращает остальные новым списком
+func (sl *SinglyList) SplitAt(n int) (*SinglyList, error) {
+	if n < 0 || n > sl.size {
+		return nil, fmt.Errorf("index out of range")
+	}
+	rest := NewSinglyList()
+	if n == sl.size {
+		return rest, nil
+	}
+	if n == 0 {
+		rest.head, rest.tail, rest.size = sl.head, sl.tail, sl.size
+		sl.Clear()
+		return rest, nil
+	}
+
+	current := sl.head
+	for i := 1; i < n; i++ {
+		current = current.next
+	}
+	rest.head = current.next
+	rest.tail = sl.tail
+	rest.size = sl.size - n
+	current.next = nil
+	sl.tail = current
+	sl.size = n
+	return rest, nil
+}
+
+// MergeSorted сливает отсортированный other в отсортированный список, оставляя other пустым
+func (sl *SinglyList) MergeSorted(other *SinglyList, less func(x, y string) bool) {
+	if other == sl {
+		return
+	}
+	sl.head = mergeNodes(sl.head, other.head, less)
+	sl.size += other.size
+	other.Clear()
+	sl.tail = sl.head
+	for sl.tail != nil && sl.tail.next != nil {
+		sl.tail = sl.tail.next
+	}
+}
+
+// Sort — устойчивая сортировка слиянием за O(n log n) без выделения памяти под узлы
+func (sl *SinglyList) Sort(less func(x, y string) bool) {
+	sl.head = mergeSortNodes(sl.head, less)
+	sl.tail = sl.head
+	for sl.tail != nil && sl.tail.next != nil {
+		sl.tail = sl.tail.next
+	}
+}
+
+func mergeSortNodes(head *SNode, less func(x, y string) bool) *SNode {
+	if head == nil || head.next == nil {
+		return head
+	}
+
+	slow, fast := head, head.next
+	for fast != nil && fast.next != nil {
+		slow = slow.next
+		fast = fast.next.next
+	}
+	second := slow.next
+	slow.next = nil
+
+	return mergeNodes(mergeSortNodes(head, less), mergeSortNodes(second, less), less)
+}
+
+func mergeNodes(a, b *SNode, less func(x, y string) bool) *SNode {
+	dummy := &SNode{}
+	tail := dummy
+	for a != nil && b != nil {
+		if less(b.data, a.data) {
+			tail.next = b
+			b = b.next
+		} else {
+			tail.next = a
+			a = a.next
+		}
+		tail = tail.next
+	}
+	if a != nil {
+		tail.next = a
+	} else {
+		tail.next = b
+	}
+	return dummy.next
+}
+
+// HasCycle проверяет список на цикл алгоритмом Флойда
+func (sl *SinglyList) HasCycle() bool {
+	slow, fast := sl.head, sl.head
+	for fast != nil && fast.next != nil {
+		slow = slow.next
+		fast = fast.next.next
+		if slow == fast {
+			return true
+		}
+	}
+	return false
+}
+
+func (sl *SinglyList) Nth(n int) (string, error) {
+	if n < 0 || n >= sl.size {
+		return "", fmt.Errorf("index out of range")
+	}
+	current := sl.head
+	for i := 0; i < n; i++ {
+		current = current.next
+	}
+	return current.data, nil
+}
+
+// NthFromEnd возвращает n-й элемент с конца; NthFromEnd(0) — последний элемент
+func (sl *SinglyList) NthFromEnd(n int) (string, error) {
+	if n < 0 || n >= sl.size {
+		return "", fmt.Errorf("index out of range")
+	}
+	lead := sl.head
+	for i := 0; i < n; i++ {
+		lead = lead.next
+	}
+	current := sl.head
+	for lead.next != nil {
+		lead = lead.next
+		current = current.next
+	}
+	return current.data, nil
 }
 
 func (sl *SinglyList) Search(val string) bool {
@@ -2,6 +2,7 @@ package main
 
 import (
 	"os"
+	"slices"
 	"testing"
 )
 
@@ -252,3 +253,189 @@ func TestSinglyListPrintMethod(t *testing.T) {
 	list.PushBack("test")
 	list.Print()
 }
+
+func TestSingleListNotFoundErrors(t *testing.T) {
+	list := NewSinglyList()
+	if list.InsertAfter("x", "y") != ErrNotFound {
+		t.Error("Expected ErrNotFound for InsertAfter on empty list")
+	}
+	if list.InsertBefore("x", "y") != ErrNotFound {
+		t.Error("Expected ErrNotFound for InsertBefore on empty list")
+	}
+	if list.RemoveByValue("x") != ErrNotFound {
+		t.Error("Expected ErrNotFound for RemoveByValue on empty list")
+	}
+
+	list.PushBack("a")
+	if list.InsertAfter("missing", "y") != ErrNotFound {
+		t.Error("Expected ErrNotFound for missing target")
+	}
+	if list.InsertBefore("missing", "y") != ErrNotFound {
+		t.Error("Expected ErrNotFound for missing target")
+	}
+	if list.RemoveByValue("missing") != ErrNotFound {
+		t.Error("Expected ErrNotFound for missing value")
+	}
+	if list.InsertAfter("a", "b") != nil {
+		t.Error("Expected InsertAfter to succeed")
+	}
+	if list.GetSize() != 2 {
+		t.Errorf("Expected size 2, got %d", list.GetSize())
+	}
+}
+
+func TestSingleListDuplicates(t *testing.T) {
+	list := NewSinglyList()
+	for _, v := range []string{"x", "a", "x", "b", "x"} {
+		list.PushBack(v)
+	}
+
+	if list.CountOf("x") != 3 {
+		t.Errorf("Expected 3 occurrences, got %d", list.CountOf("x"))
+	}
+	if list.IndexOf("x") != 0 || list.LastIndexOf("x") != 4 {
+		t.Errorf("Expected indexes 0 and 4, got %d and %d", list.IndexOf("x"), list.LastIndexOf("x"))
+	}
+	if list.IndexOf("z") != -1 || list.LastIndexOf("z") != -1 {
+		t.Error("Expected -1 for missing value")
+	}
+
+	if list.InsertAfterAll("x", "x") != 3 {
+		t.Error("Expected 3 insertions")
+	}
+	if list.GetSize() != 8 {
+		t.Errorf("Expected size 8, got %d", list.GetSize())
+	}
+
+	if list.RemoveAll("x") != 6 {
+		t.Error("Expected 6 removals")
+	}
+	got := slices.Collect(list.All())
+	if !equalSlices(got, []string{"a", "b"}) {
+		t.Errorf("Unexpected list %v", got)
+	}
+
+	list.PushBack("tail")
+	if list.GetSize() != 3 {
+		t.Errorf("Expected size 3, got %d", list.GetSize())
+	}
+}
+
+func newSinglyListOf(values ...string) *SinglyList {
+	list := NewSinglyList()
+	for _, v := range values {
+		list.PushBack(v)
+	}
+	return list
+}
+
+func TestSingleListReverse(t *testing.T) {
+	list := newSinglyListOf("1", "2", "3")
+	list.Reverse()
+
+	got := slices.Collect(list.All())
+	if !equalSlices(got, []string{"3", "2", "1"}) {
+		t.Errorf("Unexpected reversed list %v", got)
+	}
+
+	list.PushBack("0")
+	list.PopBack()
+	list.PopBack()
+	if got := slices.Collect(list.All()); !equalSlices(got, []string{"3", "2"}) {
+		t.Errorf("Unexpected list after PopBack %v", got)
+	}
+
+	empty := NewSinglyList()
+	empty.Reverse()
+	if empty.GetSize() != 0 {
+		t.Errorf("Expected size 0, got %d", empty.GetSize())
+	}
+}
+
+func TestSingleListSortAndMerge(t *testing.T) {
+	less := func(x, y string) bool { return x < y }
+
+	list := newSinglyListOf("d", "a", "c", "b", "e")
+	list.Sort(less)
+	if got := slices.Collect(list.All()); !equalSlices(got, []string{"a", "b", "c", "d", "e"}) {
+		t.Errorf("Unexpected sorted list %v", got)
+	}
+
+	list.PushBack("z")
+	if list.GetSize() != 6 {
+		t.Errorf("Expected size 6, got %d", list.GetSize())
+	}
+
+	first := newSinglyListOf("a", "c", "e")
+	second := newSinglyListOf("b", "d", "f", "g")
+	first.MergeSorted(second, less)
+	if got := slices.Collect(first.All()); !equalSlices(got, []string{"a", "b", "c", "d", "e", "f", "g"}) {
+		t.Errorf("Unexpected merged list %v", got)
+	}
+	if first.GetSize() != 7 || second.GetSize() != 0 {
+		t.Errorf("Unexpected sizes %d and %d", first.GetSize(), second.GetSize())
+	}
+
+	first.PushBack("h")
+	if got, _ := first.NthFromEnd(0); got != "h" {
+		t.Errorf("Expected tail 'h' after merge, got '%s'", got)
+	}
+}
+
+func TestSingleListSpliceSplit(t *testing.T) {
+	list := newSinglyListOf("1", "2")
+	other := newSinglyListOf("3", "4")
+	list.Splice(other)
+
+	if got := slices.Collect(list.All()); !equalSlices(got, []string{"1", "2", "3", "4"}) {
+		t.Errorf("Unexpected spliced list %v", got)
+	}
+	if other.GetSize() != 0 {
+		t.Errorf("Expected spliced list to be empty, got %d", other.GetSize())
+	}
+
+	rest, err := list.SplitAt(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := slices.Collect(list.All()); !equalSlices(got, []string{"1"}) {
+		t.Errorf("Unexpected head part %v", got)
+	}
+	if got := slices.Collect(rest.All()); !equalSlices(got, []string{"2", "3", "4"}) {
+		t.Errorf("Unexpected rest part %v", got)
+	}
+
+	all, _ := rest.SplitAt(0)
+	if rest.GetSize() != 0 || all.GetSize() != 3 {
+		t.Errorf("Unexpected sizes %d and %d", rest.GetSize(), all.GetSize())
+	}
+	if _, err := all.SplitAt(4); err == nil {
+		t.Error("Expected error for SplitAt past the end")
+	}
+}
+
+func TestSingleListNthAndCycle(t *testing.T) {
+	list := newSinglyListOf("a", "b", "c")
+
+	if val, _ := list.Nth(1); val != "b" {
+		t.Errorf("Expected 'b', got '%s'", val)
+	}
+	if val, _ := list.NthFromEnd(2); val != "a" {
+		t.Errorf("Expected 'a', got '%s'", val)
+	}
+	if _, err := list.Nth(3); err == nil {
+		t.Error("Expected error for Nth(3)")
+	}
+	if _, err := list.NthFromEnd(-1); err == nil {
+		t.Error("Expected error for NthFromEnd(-1)")
+	}
+
+	if list.HasCycle() {
+		t.Error("Expected no cycle")
+	}
+	list.tail.next = list.head
+	if !list.HasCycle() {
+		t.Error("Expected cycle")
+	}
+	list.tail.next = nil
+}
@@ -0,0 +1,239 @@
+package main
+
+import (
+	"fmt"
+	"iter"
+	"math/rand"
+	"time"
+)
+
+const skipListMaxLevel = 32
+
+type skipNode struct {
+	key   string
+	value string
+	next  []*skipNode
+}
+
+// SkipList — упорядоченное отображение строк на строки на вероятностном
+// списке с пропусками; ожидаемое время Put, Get и Delete — O(log n)
+type SkipList struct {
+	head  *skipNode
+	level int
+	size  int
+	rng   *rand.Rand
+}
+
+func NewSkipList() *SkipList {
+	return NewSkipListWithSeed(time.Now().UnixNano())
+}
+
+// NewSkipListWithSeed создаёт список с фиксированным зерном, чтобы уровни
+// узлов, а значит и форма списка, повторялись от запуска к запуску
+func NewSkipListWithSeed(seed int64) *SkipList {
+	return &SkipList{
+		head:  &skipNode{next: make([]*skipNode, skipListMaxLevel)},
+		level: 1,
+		rng:   rand.New(rand.NewSource(seed)),
+	}
+}
+
+func (sl *SkipList) randomLevel() int {
+	level := 1
+	for level < skipListMaxLevel && sl.rng.Intn(2) == 0 {
+		level++
+	}
+	return level
+}
+
+// findPredecessors заполняет update последними узлами с ключом меньше key
+// на каждом уровне и возвращает узел-кандидат на нижнем уровне
+func (sl *SkipList) findPredecessors(key string, update []*skipNode) *skipNode {
+	current := sl.head
+	for i := sl.level - 1; i >= 0; i-- {
+		for current.next[i] != nil && current.next[i].key < key {
+			current = current.next[i]
+		}
+		if update != nil {
+			update[i] = current
+		}
+	}
+	return current.next[0]
+}
+
+// Put добавляет пару или обновляет значение существующего ключа
+func (sl *SkipList) Put(key, value string) {
+	update := make([]*skipNode, skipListMaxLevel)
+	candidate := sl.findPredecessors(key, update)
+	if candidate != nil && candidate.key == key {
+		candidate.value = value
+		return
+	}
+
+	level := sl.randomLevel()
+	for i := sl.level; i < level; i++ {
+		update[i] = sl.head
+	}
+	sl.level = max(sl.level, level)
+
+	node := &skipNode{key: key, value: value, next: make([]*skipNode, level)}
+	for i := 0; i < level; i++ {
+		node.next[i] = update[i].next[i]
+		update[i].next[i] = node
+	}
+	sl.size++
+}
+
+func (sl *SkipList) Get(key string) (string, bool) {
+	candidate := sl.findPredecessors(key, nil)
+	if candidate != nil && candidate.key == key {
+		return candidate.value, true
+	}
+	return "", false
+}
+
+func (sl *SkipList) Contains(key string) bool {
+	_, ok := sl.Get(key)
+	return ok
+}
+
+func (sl *SkipList) Delete(key string) bool {
+	update := make([]*skipNode, skipListMaxLevel)
+	candidate := sl.findPredecessors(key, update)
+	if candidate == nil || candidate.key != key {
+		return false
+	}
+
+	for i := 0; i < len(candidate.next); i++ {
+		update[i].next[i] = candidate.next[i]
+	}
+	for sl.level > 1 && sl.head.next[sl.level-1] == nil {
+		sl.level--
+	}
+	sl.size--
+	return true
+}
+
+func (sl *SkipList) GetSize() int {
+	return sl.size
+}
+
+func (sl *SkipList) Clear() {
+	sl.head = &skipNode{next: make([]*skipNode, skipListMaxLevel)}
+	sl.level = 1
+	sl.size = 0
+}
+
+func (sl *SkipList) First() (string, bool) {
+	if first := sl.head.next[0]; first != nil {
+		return first.key, true
+	}
+	return "", false
+}
+
+func (sl *SkipList) Last() (string, bool) {
+	current := sl.head
+	for i := sl.level - 1; i >= 0; i-- {
+		for current.next[i] != nil {
+			current = current.next[i]
+		}
+	}
+	if current == sl.head {
+		return "", false
+	}
+	return current.key, true
+}
+
+// Floor возвращает наибольший ключ, не превосходящий key
+func (sl *SkipList) Floor(key string) (string, bool) {
+	current := sl.head
+	for i := sl.level - 1; i >= 0; i-- {
+		for current.next[i] != nil && current.next[i].key <= key {
+			current = current.next[i]
+		}
+	}
+	if current == sl.head {
+		return "", false
+	}
+	return current.key, true
+}
+
+// Ceiling возвращает наименьший ключ, не меньший key
+func (sl *SkipList) Ceiling(key string) (string, bool) {
+	if candidate := sl.findPredecessors(key, nil); candidate != nil {
+		return candidate.key, true
+	}
+	return "", false
+}
+
+// Range возвращает ключи из отрезка [from, to] по возрастанию
+func (sl *SkipList) Range(from, to string) []string {
+	result := make([]string, 0)
+	for node := sl.findPredecessors(from, nil); node != nil && node.key <= to; node = node.next[0] {
+		result = append(result, node.key)
+	}
+	return result
+}
+
+// Each обходит пары по возрастанию ключей, пока fn возвращает true
+func (sl *SkipList) Each(fn func(key, value string) bool) {
+	for node := sl.head.next[0]; node != nil; node = node.next[0] {
+		if !fn(node.key, node.value) {
+			return
+		}
+	}
+}
+
+func (sl *SkipList) All() iter.Seq2[string, string] {
+	return func(yield func(string, string) bool) {
+		sl.Each(yield)
+	}
+}
+
+// pairsArray раскладывает пары в Array как ключ, значение, ключ, значение...
+// по возрастанию ключей; так файлы читаются и пишутся через Array
+func (sl *SkipList) pairsArray() *Array {
+	arr := NewArray(2 * sl.size)
+	sl.Each(func(key, value string) bool {
+		arr.AppendAll(key, value)
+		return true
+	})
+	return arr
+}
+
+func (sl *SkipList) loadPairs(arr *Array) error {
+	if arr.GetSize()%2 != 0 {
+		return fmt.Errorf("corrupted skip list file")
+	}
+	sl.Clear()
+	for i := 0; i < arr.GetSize(); i += 2 {
+		key, _ := arr.Get(i)
+		value, _ := arr.Get(i + 1)
+		sl.Put(key, value)
+	}
+	return nil
+}
+
+func (sl *SkipList) SaveToText(filename string) error {
+	return sl.pairsArray().SaveToText(filename)
+}
+
+func (sl *SkipList) LoadFromText(filename string) error {
+	arr := NewArray(0)
+	if err := arr.LoadFromText(filename); err != nil {
+		return err
+	}
+	return sl.loadPairs(arr)
+}
+
+func (sl *SkipList) SaveToBinary(filename string) error {
+	return sl.pairsArray().SaveToBinary(filename)
+}
+
+func (sl *SkipList) LoadFromBinary(filename string) error {
+	arr := NewArray(0)
+	if err := arr.LoadFromBinary(filename); err != nil {
+		return err
+	}
+	return sl.loadPairs(arr)
+}
@@ -0,0 +1,210 @@
+package main
+
+import (
+	"math/rand"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"testing"
+)
+
+func newFruitSkipList() *SkipList {
+	sl := NewSkipListWithSeed(1)
+	for _, key := range []string{"kiwi", "apple", "mango", "banana", "cherry"} {
+		sl.Put(key, key+"-value")
+	}
+	return sl
+}
+
+func TestSkipListPutGetDelete(t *testing.T) {
+	sl := newFruitSkipList()
+
+	if sl.GetSize() != 5 {
+		t.Errorf("Expected size 5, got %d", sl.GetSize())
+	}
+	if val, ok := sl.Get("mango"); !ok || val != "mango-value" {
+		t.Errorf("Expected 'mango-value', got '%s'", val)
+	}
+	if _, ok := sl.Get("grape"); ok {
+		t.Error("Expected grape to be missing")
+	}
+
+	sl.Put("mango", "ripe")
+	if val, _ := sl.Get("mango"); val != "ripe" || sl.GetSize() != 5 {
+		t.Errorf("Expected Put to update value, got '%s' with size %d", val, sl.GetSize())
+	}
+
+	if !sl.Delete("apple") || sl.Delete("apple") {
+		t.Error("Expected apple to be deleted exactly once")
+	}
+	if sl.Contains("apple") || sl.GetSize() != 4 {
+		t.Errorf("Expected apple to be gone, size %d", sl.GetSize())
+	}
+
+	sl.Clear()
+	if sl.GetSize() != 0 || sl.Contains("kiwi") {
+		t.Error("Expected empty list after Clear")
+	}
+}
+
+func TestSkipListOrderedQueries(t *testing.T) {
+	sl := newFruitSkipList()
+
+	if first, ok := sl.First(); !ok || first != "apple" {
+		t.Errorf("Expected first 'apple', got '%s'", first)
+	}
+	if last, ok := sl.Last(); !ok || last != "mango" {
+		t.Errorf("Expected last 'mango', got '%s'", last)
+	}
+
+	cases := []struct {
+		key, floor, ceiling string
+		floorOK, ceilingOK  bool
+	}{
+		{"banana", "banana", "banana", true, true},
+		{"c", "banana", "cherry", true, true},
+		{"a", "", "apple", false, true},
+		{"z", "mango", "", true, false},
+	}
+	for _, c := range cases {
+		if got, ok := sl.Floor(c.key); got != c.floor || ok != c.floorOK {
+			t.Errorf("Floor(%q): expected %q, %v; got %q, %v", c.key, c.floor, c.floorOK, got, ok)
+		}
+		if got, ok := sl.Ceiling(c.key); got != c.ceiling || ok != c.ceilingOK {
+			t.Errorf("Ceiling(%q): expected %q, %v; got %q, %v", c.key, c.ceiling, c.ceilingOK, got, ok)
+		}
+	}
+
+	if got := sl.Range("banana", "kiwi"); !slices.Equal(got, []string{"banana", "cherry", "kiwi"}) {
+		t.Errorf("Unexpected range %v", got)
+	}
+	if got := sl.Range("b", "c"); !slices.Equal(got, []string{"banana"}) {
+		t.Errorf("Unexpected range %v", got)
+	}
+	if got := sl.Range("x", "a"); len(got) != 0 {
+		t.Errorf("Expected empty range, got %v", got)
+	}
+
+	empty := NewSkipList()
+	if _, ok := empty.First(); ok {
+		t.Error("Expected no first key in empty list")
+	}
+	if _, ok := empty.Last(); ok {
+		t.Error("Expected no last key in empty list")
+	}
+}
+
+func TestSkipListIteration(t *testing.T) {
+	sl := newFruitSkipList()
+
+	keys := make([]string, 0)
+	for key, value := range sl.All() {
+		if value != key+"-value" {
+			t.Errorf("Unexpected value '%s' for '%s'", value, key)
+		}
+		keys = append(keys, key)
+	}
+	if !slices.Equal(keys, []string{"apple", "banana", "cherry", "kiwi", "mango"}) {
+		t.Errorf("Unexpected iteration order %v", keys)
+	}
+
+	count := 0
+	sl.Each(func(key, value string) bool {
+		count++
+		return key != "banana"
+	})
+	if count != 2 {
+		t.Errorf("Expected Each to stop after 2 pairs, got %d", count)
+	}
+}
+
+func TestSkipListSeedIsDeterministic(t *testing.T) {
+	levels := func() []int {
+		sl := NewSkipListWithSeed(42)
+		for i := 0; i < 100; i++ {
+			sl.Put(strconv.Itoa(i), "")
+		}
+		result := make([]int, 0)
+		for node := sl.head.next[0]; node != nil; node = node.next[0] {
+			result = append(result, len(node.next))
+		}
+		return result
+	}
+	if !slices.Equal(levels(), levels()) {
+		t.Error("Expected the same seed to build the same levels")
+	}
+}
+
+func TestSkipListMatchesSortedKeys(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+	sl := NewSkipListWithSeed(7)
+	reference := make(map[string]string)
+
+	for i := 0; i < 5000; i++ {
+		key := strconv.Itoa(rng.Intn(500))
+		if rng.Intn(3) == 0 {
+			_, existed := reference[key]
+			delete(reference, key)
+			if sl.Delete(key) != existed {
+				t.Fatalf("Delete(%q) disagrees with map", key)
+			}
+		} else {
+			reference[key] = strconv.Itoa(i)
+			sl.Put(key, strconv.Itoa(i))
+		}
+	}
+
+	expected := make([]string, 0, len(reference))
+	for key := range reference {
+		expected = append(expected, key)
+	}
+	sort.Strings(expected)
+
+	got := make([]string, 0)
+	for key, value := range sl.All() {
+		if reference[key] != value {
+			t.Errorf("Expected value '%s' for '%s', got '%s'", reference[key], key, value)
+		}
+		got = append(got, key)
+	}
+	if !slices.Equal(got, expected) || sl.GetSize() != len(expected) {
+		t.Errorf("Skip list keys differ from reference: %d vs %d keys", len(got), len(expected))
+	}
+}
+
+func TestSkipListSaveLoad(t *testing.T) {
+	sl := newFruitSkipList()
+	sl.Put("with space", "value with space")
+
+	if err := sl.SaveToText("skiplist.txt"); err != nil {
+		t.Fatal(err)
+	}
+	if err := sl.SaveToBinary("skiplist.bin"); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove("skiplist.txt")
+	defer os.Remove("skiplist.bin")
+
+	for _, load := range []func(*SkipList) error{
+		func(l *SkipList) error { return l.LoadFromText("skiplist.txt") },
+		func(l *SkipList) error { return l.LoadFromBinary("skiplist.bin") },
+	} {
+		loaded := NewSkipListWithSeed(3)
+		loaded.Put("stale", "")
+		if err := load(loaded); err != nil {
+			t.Fatal(err)
+		}
+		if loaded.GetSize() != sl.GetSize() || loaded.Contains("stale") {
+			t.Errorf("Expected %d pairs, got %d", sl.GetSize(), loaded.GetSize())
+		}
+		if val, _ := loaded.Get("with space"); val != "value with space" {
+			t.Errorf("Expected 'value with space', got '%s'", val)
+		}
+	}
+
+	os.WriteFile("skiplist.txt", []byte("1\nlonely\n"), 0644)
+	if NewSkipList().LoadFromText("skiplist.txt") == nil {
+		t.Error("Expected error for odd number of lines")
+	}
+}
@@ -0,0 +1,60 @@
+package main
+
+import "sort"
+
+// SortedArray хранит элементы Array в порядке возрастания
+type SortedArray struct {
+	arr *Array
+}
+
+func NewSortedArray(initialCapacity int) *SortedArray {
+	return &SortedArray{arr: NewArray(initialCapacity)}
+}
+
+// Insert вставляет значение после всех равных ему, сохраняя порядок вставки
+func (sa *SortedArray) Insert(value string) {
+	items := sa.arr.data[:sa.arr.size]
+	index := sort.Search(len(items), func(i int) bool {
+		return items[i] > value
+	})
+	sa.arr.InsertAt(index, value)
+}
+
+func (sa *SortedArray) Remove(value string) bool {
+	index := sa.arr.BinarySearch(value)
+	if index == -1 {
+		return false
+	}
+	sa.arr.RemoveAt(index)
+	return true
+}
+
+func (sa *SortedArray) Find(value string) int {
+	return sa.arr.BinarySearch(value)
+}
+
+func (sa *SortedArray) Get(index int) (string, error) {
+	return sa.arr.Get(index)
+}
+
+func (sa *SortedArray) GetSize() int {
+	return sa.arr.GetSize()
+}
+
+func (sa *SortedArray) Print() {
+	sa.arr.Print()
+}
+
+// Len, Less и Swap реализуют sort.Interface.
+// Swap нарушает порядок, поэтому его вызывают только алгоритмы пакета sort.
+func (sa *SortedArray) Len() int {
+	return sa.arr.size
+}
+
+func (sa *SortedArray) Less(i, j int) bool {
+	return sa.arr.data[i] < sa.arr.data[j]
+}
+
+func (sa *SortedArray) Swap(i, j int) {
+	sa.arr.data[i], sa.arr.data[j] = sa.arr.data[j], sa.arr.data[i]
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestSortedArrayInsertKeepsOrder(t *testing.T) {
+	sa := NewSortedArray(2)
+	sa.Insert("c")
+	sa.Insert("a")
+	sa.Insert("d")
+	sa.Insert("b")
+
+	expected := []string{"a", "b", "c", "d"}
+	for i, want := range expected {
+		val, _ := sa.Get(i)
+		if val != want {
+			t.Errorf("Expected '%s' at %d, got '%s'", want, i, val)
+		}
+	}
+
+	if !sort.IsSorted(sa) {
+		t.Error("Expected SortedArray to satisfy sort.IsSorted")
+	}
+}
+
+func TestSortedArrayFindRemove(t *testing.T) {
+	sa := NewSortedArray(10)
+	sa.Insert("x")
+	sa.Insert("y")
+	sa.Insert("y")
+
+	if sa.Find("y") == -1 {
+		t.Error("Expected to find 'y'")
+	}
+	if sa.Find("z") != -1 {
+		t.Errorf("Expected -1 for 'z', got %d", sa.Find("z"))
+	}
+
+	if !sa.Remove("y") {
+		t.Error("Expected Remove('y') to succeed")
+	}
+	if sa.GetSize() != 2 {
+		t.Errorf("Expected size 2, got %d", sa.GetSize())
+	}
+	if sa.Remove("missing") {
+		t.Error("Expected Remove('missing') to fail")
+	}
+}
+
+func TestSortedArraySortInterface(t *testing.T) {
+	sa := NewSortedArray(10)
+	sa.Insert("a")
+	sa.Insert("b")
+	sa.Insert("c")
+
+	sort.Sort(sort.Reverse(sa))
+	val, _ := sa.Get(0)
+	if val != "c" {
+		t.Errorf("Expected 'c' after reverse sort, got '%s'", val)
+	}
+	if sa.Len() != 3 {
+		t.Errorf("Expected Len 3, got %d", sa.Len())
+	}
+}
@@ -0,0 +1,133 @@
+package main
+
+import "iter"
+
+// SortedSet — множество строк на SkipList: элементы хранятся по возрастанию,
+// что даёт First, Last и Range в дополнение к операциям Set
+type SortedSet struct {
+	list *SkipList
+}
+
+func NewSortedSet(values ...string) *SortedSet {
+	s := &SortedSet{list: NewSkipList()}
+	for _, v := range values {
+		s.Add(v)
+	}
+	return s
+}
+
+func (s *SortedSet) Add(value string) bool {
+	if s.list.Contains(value) {
+		return false
+	}
+	s.list.Put(value, "")
+	return true
+}
+
+func (s *SortedSet) Remove(value string) bool {
+	return s.list.Delete(value)
+}
+
+func (s *SortedSet) Contains(value string) bool {
+	return s.list.Contains(value)
+}
+
+func (s *SortedSet) GetSize() int {
+	return s.list.GetSize()
+}
+
+func (s *SortedSet) Clear() {
+	s.list.Clear()
+}
+
+// Each обходит элементы по возрастанию, пока fn возвращает true
+func (s *SortedSet) Each(fn func(string) bool) {
+	s.list.Each(func(key, _ string) bool {
+		return fn(key)
+	})
+}
+
+func (s *SortedSet) All() iter.Seq[string] {
+	return s.Each
+}
+
+func (s *SortedSet) Values() []string {
+	values := make([]string, 0, s.GetSize())
+	s.Each(func(v string) bool {
+		values = append(values, v)
+		return true
+	})
+	return values
+}
+
+func (s *SortedSet) First() (string, bool) {
+	return s.list.First()
+}
+
+func (s *SortedSet) Last() (string, bool) {
+	return s.list.Last()
+}
+
+// Range возвращает элементы из отрезка [from, to] по возрастанию
+func (s *SortedSet) Range(from, to string) []string {
+	return s.list.Range(from, to)
+}
+
+func (s *SortedSet) Union(other StringSet) *SortedSet {
+	result := NewSortedSet()
+	unionInto(result, s, other)
+	return result
+}
+
+func (s *SortedSet) Intersection(other StringSet) *SortedSet {
+	result := NewSortedSet()
+	intersectionInto(result, s, other)
+	return result
+}
+
+func (s *SortedSet) Difference(other StringSet) *SortedSet {
+	result := NewSortedSet()
+	differenceInto(result, s, other)
+	return result
+}
+
+func (s *SortedSet) SymmetricDifference(other StringSet) *SortedSet {
+	result := NewSortedSet()
+	differenceInto(result, s, other)
+	differenceInto(result, other, s)
+	return result
+}
+
+func (s *SortedSet) IsSubset(other StringSet) bool {
+	return isSubset(s, other)
+}
+
+func (s *SortedSet) Equal(other StringSet) bool {
+	return s.GetSize() == other.GetSize() && isSubset(s, other)
+}
+
+func (s *SortedSet) SaveToText(filename string) error {
+	return setValuesArray(s.Values()).SaveToText(filename)
+}
+
+func (s *SortedSet) LoadFromText(filename string) error {
+	arr := NewArray(0)
+	if err := arr.LoadFromText(filename); err != nil {
+		return err
+	}
+	fillSet(s, arr)
+	return nil
+}
+
+func (s *SortedSet) SaveToBinary(filename string) error {
+	return setValuesArray(s.Values()).SaveToBinary(filename)
+}
+
+func (s *SortedSet) LoadFromBinary(filename string) error {
+	arr := NewArray(0)
+	if err := arr.LoadFromBinary(filename); err != nil {
+		return err
+	}
+	fillSet(s, arr)
+	return nil
+}
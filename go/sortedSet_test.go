@@ -0,0 +1,58 @@
+package main
+
+import (
+	"os"
+	"slices"
+	"testing"
+)
+
+func TestSortedSetOrder(t *testing.T) {
+	s := NewSortedSet("delta", "alpha", "charlie", "bravo")
+
+	if got := s.Values(); !slices.Equal(got, []string{"alpha", "bravo", "charlie", "delta"}) {
+		t.Errorf("Expected sorted values, got %v", got)
+	}
+	if first, ok := s.First(); !ok || first != "alpha" {
+		t.Errorf("Expected first 'alpha', got '%s'", first)
+	}
+	if last, ok := s.Last(); !ok || last != "delta" {
+		t.Errorf("Expected last 'delta', got '%s'", last)
+	}
+	if got := s.Range("b", "cz"); !slices.Equal(got, []string{"bravo", "charlie"}) {
+		t.Errorf("Expected [bravo charlie], got %v", got)
+	}
+
+	collected := make([]string, 0)
+	for v := range s.All() {
+		collected = append(collected, v)
+		if v == "bravo" {
+			break
+		}
+	}
+	if !slices.Equal(collected, []string{"alpha", "bravo"}) {
+		t.Errorf("Expected iteration to stop at bravo, got %v", collected)
+	}
+
+	if got := s.SymmetricDifference(NewSet("alpha", "echo")).Values(); !slices.Equal(got, []string{"bravo", "charlie", "delta", "echo"}) {
+		t.Errorf("Unexpected symmetric difference %v", got)
+	}
+}
+
+func TestSortedSetSaveLoadText(t *testing.T) {
+	s := NewSortedSet("b", "a", "c")
+	if err := s.SaveToText("sorted_set.txt"); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove("sorted_set.txt")
+
+	loaded := NewSortedSet("stale")
+	if err := loaded.LoadFromText("sorted_set.txt"); err != nil {
+		t.Fatal(err)
+	}
+	if got := loaded.Values(); !slices.Equal(got, []string{"a", "b", "c"}) {
+		t.Errorf("Expected [a b c], got %v", got)
+	}
+	if err := loaded.LoadFromBinary("missing.bin"); err == nil {
+		t.Error("Expected error for missing file")
+	}
+}
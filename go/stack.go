@@ -31,12 +31,38 @@ func (s *Stack) resize() {
 	if newCapacity == 0 {
 		newCapacity = 1
 	}
+	s.resizeTo(newCapacity)
+}
+
+func (s *Stack) resizeTo(newCapacity int) {
 	newData := make([]string, newCapacity)
 	copy(newData, s.data[:s.size])
 	s.data = newData
 	s.capacity = newCapacity
 }
 
+func (s *Stack) shrink() {
+	if newCapacity, ok := shrinkTarget(s.size, s.capacity); ok {
+		s.resizeTo(newCapacity)
+	}
+}
+
+func (s *Stack) Cap() int {
+	return s.capacity
+}
+
+func (s *Stack) Reserve(n int) {
+	if n > s.capacity {
+		s.resizeTo(n)
+	}
+}
+
+func (s *Stack) ShrinkToFit() {
+	if s.capacity != s.size {
+		s.resizeTo(s.size)
+	}
+}
+
 func (s *Stack) Push(value string) {
 	if s.size >= s.capacity {
 		s.resize()
@@ -50,7 +76,9 @@ func (s *Stack) Pop() string {
 		return ""
 	}
 	val := s.data[s.size-1]
+	s.data[s.size-1] = ""
 	s.size--
+	s.shrink()
 	return val
 }
 
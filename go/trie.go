@@ -0,0 +1,197 @@
+package main
+
+import "slices"
+
+// PrefixTree — общий набор операций префиксных деревьев над строковыми ключами
+type PrefixTree interface {
+	Insert(key string) bool
+	Delete(key string) bool
+	Contains(key string) bool
+	HasPrefix(prefix string) bool
+	KeysWithPrefix(prefix string) []string
+	LongestPrefixOf(s string) (string, bool)
+	GetSize() int
+	Clear()
+}
+
+type trieNode struct {
+	children map[byte]*trieNode
+	terminal bool
+}
+
+// Trie — префиксное дерево с одним байтом ключа на ребро
+type Trie struct {
+	root *trieNode
+	size int
+}
+
+func NewTrie() *Trie {
+	return &Trie{root: &trieNode{children: make(map[byte]*trieNode)}}
+}
+
+// Insert добавляет ключ и возвращает false, если он уже был
+func (t *Trie) Insert(key string) bool {
+	node := t.root
+	for i := 0; i < len(key); i++ {
+		child := node.children[key[i]]
+		if child == nil {
+			child = &trieNode{children: make(map[byte]*trieNode)}
+			node.children[key[i]] = child
+		}
+		node = child
+	}
+	if node.terminal {
+		return false
+	}
+	node.terminal = true
+	t.size++
+	return true
+}
+
+// Delete удаляет ключ и отрезает ставшие ненужными узлы
+func (t *Trie) Delete(key string) bool {
+	path := make([]*trieNode, 0, len(key)+1)
+	node := t.root
+	path = append(path, node)
+	for i := 0; i < len(key); i++ {
+		node = node.children[key[i]]
+		if node == nil {
+			return false
+		}
+		path = append(path, node)
+	}
+	if !node.terminal {
+		return false
+	}
+	node.terminal = false
+	t.size--
+
+	for i := len(key); i > 0; i-- {
+		current := path[i]
+		if current.terminal || len(current.children) > 0 {
+			break
+		}
+		delete(path[i-1].children, key[i-1])
+	}
+	return true
+}
+
+func (t *Trie) find(prefix string) *trieNode {
+	node := t.root
+	for i := 0; i < len(prefix) && node != nil; i++ {
+		node = node.children[prefix[i]]
+	}
+	return node
+}
+
+func (t *Trie) Contains(key string) bool {
+	node := t.find(key)
+	return node != nil && node.terminal
+}
+
+// HasPrefix сообщает, начинается ли с prefix хотя бы один ключ
+func (t *Trie) HasPrefix(prefix string) bool {
+	node := t.find(prefix)
+	return node != nil && (node.terminal || len(node.children) > 0)
+}
+
+// KeysWithPrefix возвращает ключи с данным префиксом в лексикографическом порядке
+func (t *Trie) KeysWithPrefix(prefix string) []string {
+	result := make([]string, 0)
+	if node := t.find(prefix); node != nil {
+		collectTrieKeys(node, []byte(prefix), &result)
+	}
+	return result
+}
+
+func collectTrieKeys(node *trieNode, prefix []byte, result *[]string) {
+	if node.terminal {
+		*result = append(*result, string(prefix))
+	}
+	for _, b := range sortedChildBytes(node.children) {
+		collectTrieKeys(node.children[b], append(prefix, b), result)
+	}
+}
+
+func sortedChildBytes[N any](children map[byte]N) []byte {
+	keys := make([]byte, 0, len(children))
+	for b := range children {
+		keys = append(keys, b)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// LongestPrefixOf возвращает самый длинный ключ, который является префиксом s
+func (t *Trie) LongestPrefixOf(s string) (string, bool) {
+	node := t.root
+	best := -1
+	if node.terminal {
+		best = 0
+	}
+	for i := 0; i < len(s); i++ {
+		node = node.children[s[i]]
+		if node == nil {
+			break
+		}
+		if node.terminal {
+			best = i + 1
+		}
+	}
+	if best < 0 {
+		return "", false
+	}
+	return s[:best], true
+}
+
+func (t *Trie) GetSize() int {
+	return t.size
+}
+
+func (t *Trie) Clear() {
+	t.root = &trieNode{children: make(map[byte]*trieNode)}
+	t.size = 0
+}
+
+// Файлы префиксных деревьев совпадают с файлами Array: ключи
+// записываются в лексикографическом порядке через Array.SaveToText/SaveToBinary
+
+func prefixKeysArray(tree PrefixTree) *Array {
+	keys := tree.KeysWithPrefix("")
+	arr := NewArray(len(keys))
+	arr.AppendAll(keys...)
+	return arr
+}
+
+func fillPrefixTree(tree PrefixTree, arr *Array) {
+	tree.Clear()
+	for key := range arr.All() {
+		tree.Insert(key)
+	}
+}
+
+func (t *Trie) SaveToText(filename string) error {
+	return prefixKeysArray(t).SaveToText(filename)
+}
+
+func (t *Trie) LoadFromText(filename string) error {
+	arr := NewArray(0)
+	if err := arr.LoadFromText(filename); err != nil {
+		return err
+	}
+	fillPrefixTree(t, arr)
+	return nil
+}
+
+func (t *Trie) SaveToBinary(filename string) error {
+	return prefixKeysArray(t).SaveToBinary(filename)
+}
+
+func (t *Trie) LoadFromBinary(filename string) error {
+	arr := NewArray(0)
+	if err := arr.LoadFromBinary(filename); err != nil {
+		return err
+	}
+	fillPrefixTree(t, arr)
+	return nil
+}
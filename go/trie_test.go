@@ -0,0 +1,176 @@
+package main
+
+import (
+	"os"
+	"slices"
+	"testing"
+)
+
+var (
+	_ PrefixTree = (*Trie)(nil)
+	_ PrefixTree = (*RadixTree)(nil)
+)
+
+func prefixTrees() map[string]func() PrefixTree {
+	return map[string]func() PrefixTree{
+		"trie":  func() PrefixTree { return NewTrie() },
+		"radix": func() PrefixTree { return NewRadixTree() },
+	}
+}
+
+func fillWords(tree PrefixTree) {
+	for _, word := range []string{"car", "cart", "carbon", "cat", "dog", "do"} {
+		tree.Insert(word)
+	}
+}
+
+func TestPrefixTreeInsertContains(t *testing.T) {
+	for name, newTree := range prefixTrees() {
+		tree := newTree()
+		fillWords(tree)
+
+		if tree.GetSize() != 6 {
+			t.Errorf("%s: expected size 6, got %d", name, tree.GetSize())
+		}
+		if tree.Insert("cart") {
+			t.Errorf("%s: expected duplicate insert to return false", name)
+		}
+		for _, word := range []string{"car", "cart", "do", "dog"} {
+			if !tree.Contains(word) {
+				t.Errorf("%s: expected %s to be present", name, word)
+			}
+		}
+		for _, word := range []string{"ca", "d", "carts", "", "cab"} {
+			if tree.Contains(word) {
+				t.Errorf("%s: expected %s to be absent", name, word)
+			}
+		}
+	}
+}
+
+func TestPrefixTreePrefixQueries(t *testing.T) {
+	for name, newTree := range prefixTrees() {
+		tree := newTree()
+		fillWords(tree)
+
+		if got := tree.KeysWithPrefix("car"); !slices.Equal(got, []string{"car", "carbon", "cart"}) {
+			t.Errorf("%s: unexpected keys with prefix 'car': %v", name, got)
+		}
+		if got := tree.KeysWithPrefix(""); !slices.Equal(got, []string{"car", "carbon", "cart", "cat", "do", "dog"}) {
+			t.Errorf("%s: unexpected keys: %v", name, got)
+		}
+		if got := tree.KeysWithPrefix("cab"); len(got) != 0 {
+			t.Errorf("%s: expected no keys with prefix 'cab', got %v", name, got)
+		}
+
+		if !tree.HasPrefix("carb") || !tree.HasPrefix("d") || !tree.HasPrefix("") {
+			t.Errorf("%s: expected prefixes to be found", name)
+		}
+		if tree.HasPrefix("cab") || tree.HasPrefix("dogs") {
+			t.Errorf("%s: unexpected prefix found", name)
+		}
+
+		cases := []struct {
+			s, want string
+			ok      bool
+		}{
+			{"cartoon", "cart", true},
+			{"carbonate", "carbon", true},
+			{"carb", "car", true},
+			{"dog", "dog", true},
+			{"ca", "", false},
+			{"zebra", "", false},
+		}
+		for _, c := range cases {
+			if got, ok := tree.LongestPrefixOf(c.s); got != c.want || ok != c.ok {
+				t.Errorf("%s: LongestPrefixOf(%q) = %q, %v; expected %q, %v", name, c.s, got, ok, c.want, c.ok)
+			}
+		}
+	}
+}
+
+func TestPrefixTreeEmptyKey(t *testing.T) {
+	for name, newTree := range prefixTrees() {
+		tree := newTree()
+		if tree.HasPrefix("") {
+			t.Errorf("%s: expected empty tree to have no keys", name)
+		}
+		tree.Insert("")
+		if !tree.Contains("") || tree.GetSize() != 1 {
+			t.Errorf("%s: expected empty key to be stored", name)
+		}
+		if got, ok := tree.LongestPrefixOf("abc"); !ok || got != "" {
+			t.Errorf("%s: expected empty key as longest prefix, got %q, %v", name, got, ok)
+		}
+		if !tree.Delete("") || tree.GetSize() != 0 {
+			t.Errorf("%s: expected empty key to be deleted", name)
+		}
+	}
+}
+
+func TestPrefixTreeDelete(t *testing.T) {
+	for name, newTree := range prefixTrees() {
+		tree := newTree()
+		fillWords(tree)
+
+		if tree.Delete("ca") {
+			t.Errorf("%s: expected deleting a missing key to return false", name)
+		}
+		if !tree.Delete("car") {
+			t.Errorf("%s: expected car to be deleted", name)
+		}
+		if tree.Contains("car") || !tree.Contains("cart") || !tree.Contains("carbon") {
+			t.Errorf("%s: delete affected other keys", name)
+		}
+		if !tree.Delete("cart") || !tree.Delete("carbon") {
+			t.Errorf("%s: expected keys to be deleted", name)
+		}
+		if tree.HasPrefix("car") {
+			t.Errorf("%s: expected no keys with prefix 'car' left", name)
+		}
+		if got := tree.KeysWithPrefix(""); !slices.Equal(got, []string{"cat", "do", "dog"}) {
+			t.Errorf("%s: unexpected keys after delete: %v", name, got)
+		}
+		if tree.GetSize() != 3 {
+			t.Errorf("%s: expected size 3, got %d", name, tree.GetSize())
+		}
+
+		tree.Clear()
+		if tree.GetSize() != 0 || tree.Contains("cat") {
+			t.Errorf("%s: expected empty tree after Clear", name)
+		}
+	}
+}
+
+func TestTrieSaveLoad(t *testing.T) {
+	tree := NewTrie()
+	fillWords(tree)
+	tree.Insert("with space")
+
+	if err := tree.SaveToText("trie.txt"); err != nil {
+		t.Fatal(err)
+	}
+	if err := tree.SaveToBinary("trie.bin"); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove("trie.txt")
+	defer os.Remove("trie.bin")
+
+	fromText := NewTrie()
+	fromText.Insert("stale")
+	if err := fromText.LoadFromText("trie.txt"); err != nil {
+		t.Fatal(err)
+	}
+	fromBinary := NewTrie()
+	if err := fromBinary.LoadFromBinary("trie.bin"); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := tree.KeysWithPrefix("")
+	if got := fromText.KeysWithPrefix(""); !slices.Equal(got, expected) {
+		t.Errorf("Expected %v from text, got %v", expected, got)
+	}
+	if got := fromBinary.KeysWithPrefix(""); !slices.Equal(got, expected) {
+		t.Errorf("Expected %v from binary, got %v", expected, got)
+	}
+}